@@ -5,34 +5,34 @@ data "hyperfabric_device" "hyperfabric_device" {
 output "datasource_device_leaf1_device_id" {
   value = data.hyperfabric_device.hyperfabric_device.device_id
 }
-# data hyperfabric_device "leaf2" {
-#   serial_number = "TFAB97116234"
-# }
 
-# data hyperfabric_device "spine1" {
-#   serial_number = "TFAB16626855"
-# }
+data "hyperfabric_devices" "unbound_devices" {
+  unbound    = true
+  model_name = "HF6100-32D"
+}
 
-# data hyperfabric_device "spine2" {
-#   serial_number = "TFAB56169759"
-# }
+output "datasource_unbound_devices_serial_numbers" {
+  value = data.hyperfabric_devices.unbound_devices.devices[*].serial_number
+}
 
-# resource hyperfabric_bind_to_node "leaf1" {
-#   node_id = hyperfabric_node.node1.id
-#   device_id = data.hyperfabric_device.hyperfabric_device.id
-# }
+resource "hyperfabric_bind_to_node" "leaf1" {
+  node_id   = hyperfabric_node.node1.id
+  device_id = data.hyperfabric_device.hyperfabric_device.id
+}
 
-# resource hyperfabric_bind_to_node "leaf2" {
-#   node_id = hyperfabric_node.node2.id
-#   device_id = data.hyperfabric_device.leaf2.id
-# }
+resource "hyperfabric_bind_to_node" "leaf2" {
+  node_id       = hyperfabric_node.node2.id
+  serial_number = "TFAB97116234"
+}
 
-# resource hyperfabric_bind_to_node "spine1" {
-#   node_id = hyperfabric_node.node3.id
-#   device_id = data.hyperfabric_device.spine1.id
-# }
+# The Device is found using the serial_number of the Node and bound as soon as it appears in the inventory.
+resource "hyperfabric_bind_to_node" "spine1" {
+  node_id      = hyperfabric_node.node3.id
+  wait_timeout = "30m"
+}
 
-# resource hyperfabric_bind_to_node "spine2" {
-#   node_id = hyperfabric_node.node4.id
-#   device_id = data.hyperfabric_device.spine2.id
-# }
+resource "hyperfabric_bind_to_node" "spine2" {
+  node_id       = hyperfabric_node.node4.id
+  serial_number = "TFAB56169759"
+  wait_timeout  = "30m"
+}
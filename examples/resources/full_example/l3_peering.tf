@@ -58,3 +58,26 @@ resource "hyperfabric_node_sub_interface" "node1_Ethernet1_10_101" {
     }
   ]
 }
+
+resource "hyperfabric_node_bgp_neighbor" "node1_border_router" {
+  node_id          = hyperfabric_node.node1.id
+  name             = "border-router"
+  description      = "eBGP session to the border router"
+  vrf_id           = hyperfabric_vrf.vrf1.vrf_id
+  peer_address     = "10.4.0.2"
+  remote_asn       = 65001
+  update_source    = hyperfabric_node_loopback.node1_loopback11.name
+  password         = "my_super_secret_password3"
+  password_version = 1
+  bfd              = true
+  address_families = [
+    {
+      type                = "IPV4_UNICAST"
+      max_prefixes        = 1000
+      max_prefixes_action = "WARNING"
+    },
+    {
+      type = "IPV6_UNICAST"
+    }
+  ]
+}
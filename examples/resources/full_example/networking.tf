@@ -1,6 +1,7 @@
 resource "hyperfabric_vrf" "vrf1" {
-  fabric_id = hyperfabric_fabric.fab1.id
-  name      = "VRF1"
+  fabric_id            = hyperfabric_fabric.fab1.id
+  name                 = "VRF1"
+  export_route_targets = ["65000:100"]
 }
 
 resource "hyperfabric_vrf" "vrf2" {
@@ -28,8 +29,39 @@ resource "hyperfabric_vrf" "vrf2" {
 }
 
 resource "hyperfabric_vrf" "vrf3" {
+  fabric_id              = hyperfabric_fabric.fab1.id
+  name                   = "VRF4"
+  import_route_targets   = ["65000:100"]
+  import_route_policy_id = hyperfabric_route_policy.shared_services.route_policy_id
+}
+
+resource "hyperfabric_prefix_list" "shared_services" {
+  fabric_id = hyperfabric_fabric.fab1.id
+  name      = "shared-services"
+  entries = [
+    {
+      sequence = 10
+      action   = "PERMIT"
+      prefix   = "10.100.0.0/16"
+      le       = 24
+    }
+  ]
+}
+
+resource "hyperfabric_route_policy" "shared_services" {
   fabric_id = hyperfabric_fabric.fab1.id
-  name      = "VRF4"
+  name      = "leak-shared-services"
+  statements = [
+    {
+      sequence             = 10
+      action               = "PERMIT"
+      match_prefix_list_id = hyperfabric_prefix_list.shared_services.prefix_list_id
+    },
+    {
+      sequence = 20
+      action   = "DENY"
+    }
+  ]
 }
 
 resource "hyperfabric_vni" "vni1" {
@@ -59,6 +59,7 @@ type Client struct {
 	// lockRequest        sync.Mutex
 	changedFabrics    map[string]string
 	lockChangedFabric sync.Mutex
+	lockedObjects     sync.Map
 }
 
 // singleton implementation of a client
@@ -154,6 +155,14 @@ func (c *Client) AddChangedFabric(fabricId string) {
 	c.lockChangedFabric.Unlock()
 }
 
+// LockObject serializes the read-modify-write updates of an object shared by several resources. The lock of the object
+// identified by key is held until the returned function is called.
+func (c *Client) LockObject(key string) func() {
+	lock, _ := c.lockedObjects.LoadOrStore(key, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
 func (c *Client) DoAutoCommit() {
 	if clientImpl != nil {
 		clientImpl.lockChangedFabric.Lock()
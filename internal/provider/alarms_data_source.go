@@ -0,0 +1,421 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AlarmsDataSource{}
+
+var alarmSeverities = []string{"CRITICAL", "MAJOR", "MINOR", "WARNING", "INFO"}
+
+func NewAlarmsDataSource() datasource.DataSource {
+	return &AlarmsDataSource{}
+}
+
+// AlarmsDataSource defines the data source implementation.
+type AlarmsDataSource struct {
+	client *client.Client
+}
+
+// AlarmsDataSourceModel describes the data source data model.
+type AlarmsDataSourceModel struct {
+	Id          types.String         `tfsdk:"id"`
+	FabricId    types.String         `tfsdk:"fabric_id"`
+	NodeId      types.String         `tfsdk:"node_id"`
+	Severities  types.Set            `tfsdk:"severities"`
+	Since       timetypes.RFC3339    `tfsdk:"since"`
+	MaxAge      timetypes.GoDuration `tfsdk:"max_age"`
+	AlarmCounts types.Map            `tfsdk:"alarm_counts"`
+	Alarms      types.List           `tfsdk:"alarms"`
+	Events      types.List           `tfsdk:"events"`
+}
+
+// AlarmSummaryDataSourceModel describes an active alarm of a Fabric.
+type AlarmSummaryDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	Severity     types.String `tfsdk:"severity"`
+	NodeId       types.String `tfsdk:"node_id"`
+	NodeName     types.String `tfsdk:"node_name"`
+	Resource     types.String `tfsdk:"resource"`
+	Message      types.String `tfsdk:"message"`
+	RaisedAt     types.String `tfsdk:"raised_at"`
+	Acknowledged types.Bool   `tfsdk:"acknowledged"`
+}
+
+// EventSummaryDataSourceModel describes an event of a Fabric.
+type EventSummaryDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Type      types.String `tfsdk:"type"`
+	Severity  types.String `tfsdk:"severity"`
+	NodeId    types.String `tfsdk:"node_id"`
+	NodeName  types.String `tfsdk:"node_name"`
+	Resource  types.String `tfsdk:"resource"`
+	Message   types.String `tfsdk:"message"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// {
+// 	"id": "8c6a0b9e-4c1f-4a2e-9b1d-2f3c4d5e6f70",
+// 	"type": "BGP_SESSION_DOWN",
+// 	"severity": "CRITICAL",
+// 	"nodeId": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+// 	"nodeName": "leaf1",
+// 	"resource": "border-router",
+// 	"message": "BGP session to 10.1.0.1 is down",
+// 	"raisedAt": "2024-10-01T10:00:00Z",
+// 	"acknowledged": false
+// }
+
+func AlarmSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":           types.StringType,
+			"type":         types.StringType,
+			"severity":     types.StringType,
+			"node_id":      types.StringType,
+			"node_name":    types.StringType,
+			"resource":     types.StringType,
+			"message":      types.StringType,
+			"raised_at":    types.StringType,
+			"acknowledged": types.BoolType,
+		},
+	}
+}
+
+func EventSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":         types.StringType,
+			"type":       types.StringType,
+			"severity":   types.StringType,
+			"node_id":    types.StringType,
+			"node_name":  types.StringType,
+			"resource":   types.StringType,
+			"message":    types.StringType,
+			"created_at": types.StringType,
+		},
+	}
+}
+
+func (d *AlarmsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_alarms")
+	resp.TypeName = req.ProviderTypeName + "_alarms"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_alarms")
+}
+
+func (d *AlarmsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_alarms")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Alarms data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/alarms`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "The ID or the name of a Node to only return the alarms and events of the Node.",
+				Optional:            true,
+			},
+			"severities": schema.SetAttribute{
+				MarkdownDescription: "A set of severities to only return the alarms and events with one of the severities.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(alarmSeverities...)),
+				},
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "A timestamp in RFC3339 format to only return the alarms raised and the events created at or after the timestamp.",
+				Optional:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("max_age")),
+				},
+			},
+			"max_age": schema.StringAttribute{
+				MarkdownDescription: "A duration (i.e. `15m` or `1h30m`) to only return the alarms raised and the events created within the duration before the data source is read.",
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+			},
+			"alarm_counts": schema.MapAttribute{
+				MarkdownDescription: "The number of returned alarms keyed by severity.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"alarms": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the active alarms matching the filters sorted from the most recent to the oldest.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the alarm.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the alarm (i.e. `BGP_SESSION_DOWN` or `PORT_ERR_DISABLED`).",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "The severity of the alarm.",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the Node which raised the alarm.",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node which raised the alarm.",
+							Computed:            true,
+						},
+						"resource": schema.StringAttribute{
+							MarkdownDescription: "The name of the object of the Node affected by the alarm (i.e. a port or a BGP Neighbor).",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The description of the alarm.",
+							Computed:            true,
+						},
+						"raised_at": schema.StringAttribute{
+							MarkdownDescription: "The timestamp when the alarm was raised in RFC3339 format.",
+							Computed:            true,
+						},
+						"acknowledged": schema.BoolAttribute{
+							MarkdownDescription: "Whether the alarm has been acknowledged.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the recent events matching the filters sorted from the most recent to the oldest.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the event.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the event.",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "The severity of the event.",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the Node which created the event.",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node which created the event.",
+							Computed:            true,
+						},
+						"resource": schema.StringAttribute{
+							MarkdownDescription: "The name of the object of the Node concerned by the event (i.e. a port or a BGP Neighbor).",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The description of the event.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The timestamp when the event was created in RFC3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_alarms")
+}
+
+func (d *AlarmsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_alarms")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_alarms")
+}
+
+func (d *AlarmsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_alarms")
+	var data *AlarmsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/alarms", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_alarms with id '%s'", data.Id.ValueString()))
+
+	filters := getAlarmFilters(ctx, &resp.Diagnostics, data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms := make([]AlarmSummaryDataSourceModel, 0)
+	alarmCounts := map[string]int64{}
+	for _, severity := range alarmSeverities {
+		alarmCounts[severity] = 0
+	}
+	for _, alarm := range getAlarmObjectsList(ctx, &resp.Diagnostics, d.client, fmt.Sprintf("/api/v1/fabrics/%s/alarms", data.FabricId.ValueString()), "alarms", "raisedAt", filters) {
+		alarmCounts[getStringFromMap(alarm, "severity")]++
+		alarms = append(alarms, AlarmSummaryDataSourceModel{
+			Id:           basetypes.NewStringValue(getStringFromMap(alarm, "id")),
+			Type:         basetypes.NewStringValue(getStringFromMap(alarm, "type")),
+			Severity:     basetypes.NewStringValue(getStringFromMap(alarm, "severity")),
+			NodeId:       basetypes.NewStringValue(getStringFromMap(alarm, "nodeId")),
+			NodeName:     basetypes.NewStringValue(getStringFromMap(alarm, "nodeName")),
+			Resource:     basetypes.NewStringValue(getStringFromMap(alarm, "resource")),
+			Message:      basetypes.NewStringValue(getStringFromMap(alarm, "message")),
+			RaisedAt:     basetypes.NewStringValue(getStringFromMap(alarm, "raisedAt")),
+			Acknowledged: basetypes.NewBoolValue(alarm["acknowledged"] == true),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events := make([]EventSummaryDataSourceModel, 0)
+	for _, event := range getAlarmObjectsList(ctx, &resp.Diagnostics, d.client, fmt.Sprintf("/api/v1/fabrics/%s/events", data.FabricId.ValueString()), "events", "createdAt", filters) {
+		events = append(events, EventSummaryDataSourceModel{
+			Id:        basetypes.NewStringValue(getStringFromMap(event, "id")),
+			Type:      basetypes.NewStringValue(getStringFromMap(event, "type")),
+			Severity:  basetypes.NewStringValue(getStringFromMap(event, "severity")),
+			NodeId:    basetypes.NewStringValue(getStringFromMap(event, "nodeId")),
+			NodeName:  basetypes.NewStringValue(getStringFromMap(event, "nodeName")),
+			Resource:  basetypes.NewStringValue(getStringFromMap(event, "resource")),
+			Message:   basetypes.NewStringValue(getStringFromMap(event, "message")),
+			CreatedAt: basetypes.NewStringValue(getStringFromMap(event, "createdAt")),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AlarmCounts, _ = types.MapValueFrom(ctx, types.Int64Type, alarmCounts)
+	data.Alarms, _ = types.ListValueFrom(ctx, AlarmSummaryDataSourceModelAttributeType(), alarms)
+	data.Events, _ = types.ListValueFrom(ctx, EventSummaryDataSourceModelAttributeType(), events)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_alarms with id '%s'", data.Id.ValueString()))
+}
+
+// AlarmFilters describes the filters applied to the alarms and events of a Fabric.
+type AlarmFilters struct {
+	NodeId     string
+	Severities []string
+	Since      *time.Time
+}
+
+func getAlarmFilters(ctx context.Context, diags *diag.Diagnostics, data *AlarmsDataSourceModel) AlarmFilters {
+	filters := AlarmFilters{NodeId: data.NodeId.ValueString()}
+
+	// The Node ID can be provided as the id of a hyperfabric_node resource
+	if _, nodeId := splitNodeId(filters.NodeId); nodeId != "" {
+		filters.NodeId = nodeId
+	}
+
+	if !data.Severities.IsNull() && !data.Severities.IsUnknown() {
+		diags.Append(data.Severities.ElementsAs(ctx, &filters.Severities, false)...)
+	}
+
+	if !data.Since.IsNull() && !data.Since.IsUnknown() {
+		since, sinceDiags := data.Since.ValueRFC3339Time()
+		diags.Append(sinceDiags...)
+		filters.Since = &since
+	} else if !data.MaxAge.IsNull() && !data.MaxAge.IsUnknown() {
+		maxAge, maxAgeDiags := data.MaxAge.ValueGoDuration()
+		diags.Append(maxAgeDiags...)
+		since := time.Now().Add(-maxAge)
+		filters.Since = &since
+	}
+	return filters
+}
+
+// matches returns true when the alarm or event matches the filters. Objects without a valid timestamp are not filtered
+// out by the time filter, so that an alarm is never hidden because of its timestamp.
+func (f AlarmFilters) matches(object map[string]interface{}, timeKey string) bool {
+	if f.NodeId != "" && !nodeReferenceMatches(object, f.NodeId) {
+		return false
+	}
+	if len(f.Severities) > 0 && !ContainsString(f.Severities, getStringFromMap(object, "severity")) {
+		return false
+	}
+	if f.Since != nil {
+		if timestamp, err := time.Parse(time.RFC3339, getStringFromMap(object, timeKey)); err == nil && timestamp.Before(*f.Since) {
+			return false
+		}
+	}
+	return true
+}
+
+// getAlarmObjectsList returns the alarms or events matching the filters sorted from the most recent to the oldest.
+func getAlarmObjectsList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, path, listKey, timeKey string, filters AlarmFilters) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0)
+	for _, object := range getObjectsList(ctx, diags, client, path, listKey) {
+		if filters.matches(object, timeKey) {
+			objects = append(objects, object)
+		}
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		timeI, _ := time.Parse(time.RFC3339, getStringFromMap(objects[i], timeKey))
+		timeJ, _ := time.Parse(time.RFC3339, getStringFromMap(objects[j], timeKey))
+		if !timeI.Equal(timeJ) {
+			return timeI.After(timeJ)
+		}
+		return getStringFromMap(objects[i], "id") < getStringFromMap(objects[j], "id")
+	})
+	return objects
+}
@@ -75,8 +75,9 @@ func getAnnotationsSchemaAttribute() schema.SetNestedAttribute {
 					},
 					Validators: []validator.String{
 						MakeStringRequired(),
+						stringvalidator.NoneOf(managedByAnnotationName),
 					},
-					MarkdownDescription: `The name used to uniquely identify the annotation.`,
+					MarkdownDescription: "The name used to uniquely identify the annotation. The `managed-by` annotation is reserved for the provider.",
 				},
 				"value": schema.StringAttribute{
 					Optional: true,
@@ -140,21 +141,43 @@ func NewAnnotationResourceModel(data map[string]interface{}) AnnotationResourceM
 	return annotation
 }
 
-func NewAnnotationsSet(ctx context.Context, data []interface{}) basetypes.SetValue {
+func getAnnotationNames(ctx context.Context, data basetypes.SetValue) []string {
+	annotations := []AnnotationResourceModel{}
+	if !data.IsNull() && !data.IsUnknown() {
+		data.ElementsAs(ctx, &annotations, false)
+	}
+	names := make([]string, 0)
+	for _, annotation := range annotations {
+		names = append(names, annotation.Name.ValueString())
+	}
+	return names
+}
+
+// isExposedAnnotation returns true when the annotation is exposed by the plural data sources, which hide the
+// managed-by annotation and the annotations with an ignored prefix.
+func isExposedAnnotation(name string) bool {
+	return name != managedByAnnotationName && !isIgnoredLabel(name)
+}
+
+func NewAnnotationsSet(ctx context.Context, data []interface{}, declared basetypes.SetValue, ignoreExternalLabels basetypes.BoolValue) basetypes.SetValue {
+	declaredNames := getAnnotationNames(ctx, declared)
 	annotations := make([]AnnotationResourceModel, 0)
 	for _, annotation := range data {
 		newAnnotation := NewAnnotationResourceModel(annotation.(map[string]interface{}))
-		annotations = append(annotations, newAnnotation)
+		if newAnnotation.Name.ValueString() != managedByAnnotationName && isManagedLabel(newAnnotation.Name.ValueString(), declaredNames, !declared.IsNull() && !declared.IsUnknown(), isIgnoringExternalLabels(ignoreExternalLabels)) {
+			annotations = append(annotations, newAnnotation)
+		}
 	}
 	annotationsSet, _ := types.SetValueFrom(ctx, AnnotationResourceModelAttributeType(), annotations)
 	return annotationsSet
 }
 
-func NewNodeAnnotationsSet(ctx context.Context, data []interface{}) basetypes.SetValue {
+func NewNodeAnnotationsSet(ctx context.Context, data []interface{}, declared basetypes.SetValue, ignoreExternalLabels basetypes.BoolValue) basetypes.SetValue {
+	declaredNames := getAnnotationNames(ctx, declared)
 	annotations := make([]AnnotationResourceModel, 0)
 	for _, annotation := range data {
 		newAnnotation := NewAnnotationResourceModel(annotation.(map[string]interface{}))
-		if newAnnotation.Name.ValueString() != "position" {
+		if newAnnotation.Name.ValueString() != "position" && newAnnotation.Name.ValueString() != managedByAnnotationName && isManagedLabel(newAnnotation.Name.ValueString(), declaredNames, !declared.IsNull() && !declared.IsUnknown(), isIgnoringExternalLabels(ignoreExternalLabels)) {
 			annotations = append(annotations, newAnnotation)
 		}
 	}
@@ -162,9 +185,46 @@ func NewNodeAnnotationsSet(ctx context.Context, data []interface{}) basetypes.Se
 	return annotationsSet
 }
 
+// getExternalAnnotationsJsonPayload returns the annotations of the object that are not managed by the provider and
+// not already part of the payload. The position and managed-by annotations are always set by the provider itself.
+func getExternalAnnotationsJsonPayload(ctx context.Context, remoteAnnotations []interface{}, payloadAnnotations []interface{}, state basetypes.SetValue, ignoreExternalLabels bool) []map[string]string {
+	stateNames := getAnnotationNames(ctx, state)
+	payloadNames := make([]string, 0)
+	for _, annotation := range payloadAnnotations {
+		if annotationMap, ok := annotation.(map[string]interface{}); ok {
+			payloadNames = append(payloadNames, getStringFromMap(annotationMap, "name"))
+		}
+	}
+
+	externalAnnotations := []map[string]string{}
+	for _, remoteAnnotation := range remoteAnnotations {
+		annotation := NewAnnotationResourceModel(remoteAnnotation.(map[string]interface{}))
+		name := annotation.Name.ValueString()
+		if name == "position" || name == managedByAnnotationName || ContainsString(payloadNames, name) {
+			continue
+		}
+		if isExternalLabel(name, stateNames, !state.IsNull() && !state.IsUnknown(), ignoreExternalLabels) {
+			externalAnnotations = append(externalAnnotations, map[string]string{
+				"dataType": annotation.DataType.ValueString(),
+				"name":     name,
+				"value":    annotation.Value.ValueString(),
+			})
+		}
+	}
+	return externalAnnotations
+}
+
+// isAnnotationsPayloadRequired returns true when the annotations must be sent, which is when annotations are configured
+// or when the provider label must be stamped on the object to track its ownership.
+func isAnnotationsPayloadRequired(data basetypes.SetValue) bool {
+	return (!data.IsNull() && !data.IsUnknown()) || globalLabel != ""
+}
+
 func getAnnotationsJsonPayload(ctx context.Context, data basetypes.SetValue) []map[string]string {
 	annotations := []AnnotationResourceModel{}
-	data.ElementsAs(ctx, &annotations, false)
+	if !data.IsNull() && !data.IsUnknown() {
+		data.ElementsAs(ctx, &annotations, false)
+	}
 	annotationPayloads := []map[string]string{}
 	for _, annotation := range annotations {
 		annotationPayloads = append(annotationPayloads, map[string]string{
@@ -173,5 +233,9 @@ func getAnnotationsJsonPayload(ctx context.Context, data basetypes.SetValue) []m
 			"value":    StripQuotes(annotation.Value.String()),
 		})
 	}
+	// Stamp the object with the label of the provider to track its ownership
+	if globalLabel != "" {
+		annotationPayloads = append(annotationPayloads, getManagedByAnnotationPayload())
+	}
 	return annotationPayloads
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -29,6 +30,19 @@ type BearerTokenDataSource struct {
 	client *client.Client
 }
 
+// BearerTokenDataSourceModel describes the data source data model, which excludes the rotation settings of the resource.
+type BearerTokenDataSourceModel struct {
+	Id          types.String      `tfsdk:"id"`
+	TokenId     types.String      `tfsdk:"token_id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	NotAfter    timetypes.RFC3339 `tfsdk:"not_after"`
+	NotBefore   timetypes.RFC3339 `tfsdk:"not_before"`
+	Scope       types.String      `tfsdk:"scope"`
+	Token       types.String      `tfsdk:"token"`
+	Metadata    types.Object      `tfsdk:"metadata"`
+}
+
 func (d *BearerTokenDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_bearer_token")
 	resp.TypeName = req.ProviderTypeName + "_bearer_token"
@@ -109,14 +123,18 @@ func (d *BearerTokenDataSource) Configure(ctx context.Context, req datasource.Co
 
 func (d *BearerTokenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Start read of datasource: hyperfabric_bearer_token")
-	var data *BearerTokenResourceModel
+	var config *BearerTokenDataSourceModel
 
 	// Read Terraform configuration data into the model
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data := getEmptyBearerTokenResourceModel()
+	data.Id = config.Id
+	data.Name = config.Name
+
 	// Create a copy of the Id for when not found during getAndSetBearerTokenAttributes
 	cachedId := data.Id.ValueString()
 	if cachedId == "" && data.Name.ValueString() != "" {
@@ -136,6 +154,16 @@ func (d *BearerTokenDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &BearerTokenDataSourceModel{
+		Id:          data.Id,
+		TokenId:     data.TokenId,
+		Name:        data.Name,
+		Description: data.Description,
+		NotAfter:    data.NotAfter,
+		NotBefore:   data.NotBefore,
+		Scope:       data.Scope,
+		Token:       data.Token,
+		Metadata:    data.Metadata,
+	})...)
 	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_bearer_token with id '%s'", data.Id.ValueString()))
 }
@@ -0,0 +1,278 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The validity of an ephemeral Bearer Token when not_after is not provided.
+const ephemeralBearerTokenDefaultValidity = time.Hour
+
+// The key used to store the id of the Bearer Token in the private data of the ephemeral resource.
+const ephemeralBearerTokenPrivateKey = "token_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &BearerTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &BearerTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &BearerTokenEphemeralResource{}
+
+func NewBearerTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &BearerTokenEphemeralResource{}
+}
+
+// BearerTokenEphemeralResource defines the ephemeral resource implementation.
+type BearerTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// BearerTokenEphemeralResourceModel describes the ephemeral resource data model.
+type BearerTokenEphemeralResourceModel struct {
+	Id          types.String      `tfsdk:"id"`
+	TokenId     types.String      `tfsdk:"token_id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	NotAfter    timetypes.RFC3339 `tfsdk:"not_after"`
+	NotBefore   timetypes.RFC3339 `tfsdk:"not_before"`
+	Scope       types.String      `tfsdk:"scope"`
+	Token       types.String      `tfsdk:"token"`
+}
+
+type bearerTokenEphemeralResourcePrivateData struct {
+	TokenId string `json:"token_id"`
+}
+
+func (r *BearerTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of ephemeral resource: hyperfabric_bearer_token")
+	resp.TypeName = req.ProviderTypeName + "_bearer_token"
+	tflog.Debug(ctx, "End metadata of ephemeral resource: hyperfabric_bearer_token")
+}
+
+func (r *BearerTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of ephemeral resource: hyperfabric_bearer_token")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Bearer Token ephemeral resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a Bearer Token.",
+				Computed:            true,
+			},
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "The `tokenId` assigned by Hyperfabric to the Bearer Token created when the ephemeral resource is opened and revoked when it is closed. It has the same value as `id`.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The JWT token that represent the Bearer Token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Bearer Token.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the Bearer Token.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "The end date for the validity of the Bearer Token. Defaults to one hour after the token is opened.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "The start date for the validity of the Bearer Token.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "The scope assigned to the Bearer Token. Defaults to `READ_ONLY`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"ADMIN", "READ_WRITE", "READ_ONLY"}...),
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of ephemeral resource: hyperfabric_bearer_token")
+}
+
+func (r *BearerTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of ephemeral resource: hyperfabric_bearer_token")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of ephemeral resource: hyperfabric_bearer_token")
+}
+
+func (r *BearerTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Debug(ctx, "Start open of ephemeral resource: hyperfabric_bearer_token")
+
+	var data *BearerTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Open of ephemeral resource hyperfabric_bearer_token with name '%s'", data.Name.ValueString()))
+
+	if data.Scope.IsNull() || data.Scope.IsUnknown() {
+		data.Scope = basetypes.NewStringValue("READ_ONLY")
+	}
+
+	if data.NotAfter.IsNull() || data.NotAfter.IsUnknown() {
+		notAfter := time.Now().UTC().Add(ephemeralBearerTokenDefaultValidity)
+		if !data.NotBefore.IsNull() && !data.NotBefore.IsUnknown() {
+			notBefore, diags := data.NotBefore.ValueRFC3339Time()
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if notBefore.After(time.Now()) {
+				notAfter = notBefore.UTC().Add(ephemeralBearerTokenDefaultValidity)
+			}
+		}
+		data.NotAfter = timetypes.NewRFC3339TimeValue(notAfter)
+	}
+
+	tokenData := &BearerTokenResourceModel{
+		Name:        data.Name,
+		Description: data.Description,
+		NotAfter:    data.NotAfter,
+		NotBefore:   data.NotBefore,
+		Scope:       data.Scope,
+	}
+
+	jsonPayload := getBearerTokenJsonPayload(ctx, &resp.Diagnostics, tokenData, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, "/api/v1/bearerTokens", "POST", jsonPayload)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bearerTokensContainer, err := container.ArrayElement(0, "tokens")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creation of the Bearer Token failed",
+			fmt.Sprintf("The response does not contain the Bearer Token '%s'. Err: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	tokenId := StripQuotes(bearerTokensContainer.Search("tokenId").String())
+	token := StripQuotes(container.Search("token").String())
+
+	// Close is not called when Open fails, so the created token is revoked here when a later step fails.
+	defer func() {
+		if resp.Diagnostics.HasError() && tokenId != "" {
+			tflog.Debug(ctx, fmt.Sprintf("Revoke of ephemeral resource hyperfabric_bearer_token with id '%s' after a failed open", tokenId))
+			DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/bearerTokens/%s", tokenId), "DELETE", nil)
+		}
+	}()
+
+	if tokenId == "" || token == "" {
+		resp.Diagnostics.AddError(
+			"Creation of the Bearer Token failed",
+			fmt.Sprintf("The response does not contain the id or the JWT of the Bearer Token '%s'.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	privateData, err := json.Marshal(bearerTokenEphemeralResourcePrivateData{TokenId: tokenId})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Marshalling of private data failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralBearerTokenPrivateKey, privateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenData.Id = basetypes.NewStringValue(tokenId)
+	getAndSetBearerTokenAttributes(ctx, &resp.Diagnostics, r.client, tokenData)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(tokenId)
+	data.TokenId = basetypes.NewStringValue(tokenId)
+	data.Token = basetypes.NewStringValue(token)
+	data.Description = tokenData.Description
+	data.NotAfter = tokenData.NotAfter
+	data.NotBefore = tokenData.NotBefore
+	data.Scope = tokenData.Scope
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End open of ephemeral resource hyperfabric_bearer_token with id '%s'", data.Id.ValueString()))
+}
+
+func (r *BearerTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	tflog.Debug(ctx, "Start close of ephemeral resource: hyperfabric_bearer_token")
+
+	privateBytes, diags := req.Private.GetKey(ctx, ephemeralBearerTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData bearerTokenEphemeralResourcePrivateData
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		resp.Diagnostics.AddError(
+			"Unmarshalling of private data failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Close of ephemeral resource hyperfabric_bearer_token with id '%s'", privateData.TokenId))
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/bearerTokens/%s", privateData.TokenId), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End close of ephemeral resource hyperfabric_bearer_token with id '%s'", privateData.TokenId))
+}
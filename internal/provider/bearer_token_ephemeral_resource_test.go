@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBearerTokenEphemeralResource(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		// Ephemeral resources are only available in Terraform 1.10 and later.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"hyperfabric": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":        echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			// Open with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: BearerToken Ephemeral - Open with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config: testBearerTokenEphemeralResourceHclConfig(name, "minimal"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scope"), knownvalue.StringExact("READ_ONLY")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("not_after"), knownvalue.NotNull()),
+				},
+			},
+			// Open with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: BearerToken Ephemeral - Open with all config and verify provided values.")
+				},
+				Config: testBearerTokenEphemeralResourceHclConfig(name, "full"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("description"), knownvalue.StringExact("This bearer token is powered by Cisco Nexus Hyperfabric")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scope"), knownvalue.StringExact("READ_WRITE")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testBearerTokenEphemeralResourceHclConfig(name string, configType string) string {
+	if configType == "full" {
+		return fmt.Sprintf(`
+ephemeral "hyperfabric_bearer_token" "test" {
+	name        = "%[1]s"
+	description = "This bearer token is powered by Cisco Nexus Hyperfabric"
+	scope       = "READ_WRITE"
+}
+
+provider "echo" {
+	data = ephemeral.hyperfabric_bearer_token.test
+}
+
+resource "echo" "test" {}
+`, name)
+	} else {
+		return fmt.Sprintf(`
+ephemeral "hyperfabric_bearer_token" "test" {
+	name = "%[1]s"
+}
+
+provider "echo" {
+	data = ephemeral.hyperfabric_bearer_token.test
+}
+
+resource "echo" "test" {}
+`, name)
+	}
+}
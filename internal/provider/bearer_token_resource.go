@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/Jeffail/gabs/v2"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -32,6 +34,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BearerTokenResource{}
 var _ resource.ResourceWithImportState = &BearerTokenResource{}
+var _ resource.ResourceWithModifyPlan = &BearerTokenResource{}
 
 func NewBearerTokenResource() resource.Resource {
 	return &BearerTokenResource{}
@@ -53,21 +56,29 @@ type BearerTokenResourceModel struct {
 	Scope       types.String      `tfsdk:"scope"`
 	Token       types.String      `tfsdk:"token"`
 	Metadata    types.Object      `tfsdk:"metadata"`
+	// The previous Bearer Tokens retired by the rotations and not deleted yet.
+	RotatedTokenIds types.Set `tfsdk:"rotated_token_ids"`
+	// Provider settings that are not sent to the service.
+	RotateBefore    timetypes.GoDuration `tfsdk:"rotate_before"`
+	RotationOverlap timetypes.GoDuration `tfsdk:"rotation_overlap"`
 	// Labels      types.Set    `tfsdk:"labels"`
 	// Annotations types.Set    `tfsdk:"annotations"`
 }
 
 func getEmptyBearerTokenResourceModel() *BearerTokenResourceModel {
 	return &BearerTokenResourceModel{
-		Id:          basetypes.NewStringNull(),
-		TokenId:     basetypes.NewStringNull(),
-		Name:        basetypes.NewStringNull(),
-		Description: basetypes.NewStringNull(),
-		NotAfter:    timetypes.NewRFC3339Null(),
-		NotBefore:   timetypes.NewRFC3339Null(),
-		Scope:       basetypes.NewStringValue("ADMIN"),
-		Token:       basetypes.NewStringNull(),
-		Metadata:    basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Id:              basetypes.NewStringNull(),
+		TokenId:         basetypes.NewStringNull(),
+		Name:            basetypes.NewStringNull(),
+		Description:     basetypes.NewStringNull(),
+		NotAfter:        timetypes.NewRFC3339Null(),
+		NotBefore:       timetypes.NewRFC3339Null(),
+		Scope:           basetypes.NewStringValue("ADMIN"),
+		Token:           basetypes.NewStringNull(),
+		Metadata:        basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		RotatedTokenIds: basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		RotateBefore:    timetypes.NewGoDurationNull(),
+		RotationOverlap: timetypes.NewGoDurationNull(),
 		// Labels:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
 		// Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
 	}
@@ -112,6 +123,18 @@ func getNewBearerTokenResourceModelFromData(data *BearerTokenResourceModel) *Bea
 		newBearerToken.Metadata = data.Metadata
 	}
 
+	if !data.RotatedTokenIds.IsNull() && !data.RotatedTokenIds.IsUnknown() {
+		newBearerToken.RotatedTokenIds = data.RotatedTokenIds
+	}
+
+	if !data.RotateBefore.IsNull() && !data.RotateBefore.IsUnknown() {
+		newBearerToken.RotateBefore = data.RotateBefore
+	}
+
+	if !data.RotationOverlap.IsNull() && !data.RotationOverlap.IsUnknown() {
+		newBearerToken.RotationOverlap = data.RotationOverlap
+	}
+
 	return newBearerToken
 }
 
@@ -119,6 +142,56 @@ type BearerTokenIdentifier struct {
 	Id types.String
 }
 
+func (r *BearerTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		var planData, stateData, configData *BearerTokenResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// With a rotation overlap, changes are applied by rotating the Bearer Token instead of replacing it
+		rotate := !configData.RotationOverlap.IsNull() && (!planData.Description.Equal(stateData.Description) ||
+			!planData.NotAfter.Equal(stateData.NotAfter) ||
+			!planData.NotBefore.Equal(stateData.NotBefore) ||
+			!planData.Scope.Equal(stateData.Scope))
+
+		if isBearerTokenExpiring(ctx, &resp.Diagnostics, planData.RotateBefore, stateData.NotAfter) {
+			if !configData.NotAfter.IsNull() {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("not_after"),
+					"Bearer Token expiring",
+					fmt.Sprintf("The Bearer Token '%s' expires at '%s' within the 'rotate_before' duration of '%s', but it cannot be rotated because 'not_after' is configured. Update 'not_after' to rotate the Bearer Token.", stateData.Name.ValueString(), stateData.NotAfter.ValueString(), planData.RotateBefore.ValueString()),
+				)
+			} else {
+				tflog.Debug(ctx, fmt.Sprintf("Rotation of resource hyperfabric_bearer_token with id '%s' expiring at '%s'", stateData.Id.ValueString(), stateData.NotAfter.ValueString()))
+				rotate = true
+				if configData.RotationOverlap.IsNull() {
+					resp.RequiresReplace = append(resp.RequiresReplace, path.Root("not_after"))
+				}
+			}
+		}
+
+		if rotate {
+			planData.Id = basetypes.NewStringUnknown()
+			planData.TokenId = basetypes.NewStringUnknown()
+			planData.Token = basetypes.NewStringUnknown()
+			planData.Metadata = basetypes.NewObjectUnknown(MetadataResourceModelAttributeType())
+			planData.RotatedTokenIds = basetypes.NewSetUnknown(SetStringResourceModelAttributeType())
+			if configData.NotAfter.IsNull() {
+				planData.NotAfter = timetypes.NewRFC3339Unknown()
+			}
+			if configData.NotBefore.IsNull() {
+				planData.NotBefore = timetypes.NewRFC3339Unknown()
+			}
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+		}
+	}
+}
+
 func (r *BearerTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_bearer_token")
 	resp.TypeName = req.ProviderTypeName + "_bearer_token"
@@ -167,7 +240,7 @@ func (r *BearerTokenResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(requiresBearerTokenReplace, requiresBearerTokenReplaceDescription, requiresBearerTokenReplaceDescription),
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
@@ -177,7 +250,7 @@ func (r *BearerTokenResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(requiresBearerTokenReplace, requiresBearerTokenReplaceDescription, requiresBearerTokenReplaceDescription),
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 				CustomType: timetypes.RFC3339Type{},
@@ -188,7 +261,7 @@ func (r *BearerTokenResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(requiresBearerTokenReplace, requiresBearerTokenReplaceDescription, requiresBearerTokenReplaceDescription),
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 				CustomType: timetypes.RFC3339Type{},
@@ -199,7 +272,7 @@ func (r *BearerTokenResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(requiresBearerTokenReplace, requiresBearerTokenReplaceDescription, requiresBearerTokenReplaceDescription),
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 				Default: stringdefault.StaticString("READ_ONLY"),
@@ -207,6 +280,24 @@ func (r *BearerTokenResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringvalidator.OneOf([]string{"ADMIN", "READ_WRITE", "READ_ONLY"}...),
 				},
 			},
+			"rotate_before": schema.StringAttribute{
+				MarkdownDescription: "A duration (i.e. `168h`) before `not_after` within which a new Bearer Token is planned to replace the expiring Bearer Token.",
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+			},
+			"rotation_overlap": schema.StringAttribute{
+				MarkdownDescription: "A duration (i.e. `1h`) during which the previous Bearer Token remains valid after a rotation. When set, changes are applied by creating the new Bearer Token before retiring the previous one instead of replacing the Bearer Token.",
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+			},
+			"rotated_token_ids": schema.SetAttribute{
+				MarkdownDescription: "The ids of the previous Bearer Tokens retired by the rotations, which are deleted once expired by the next rotation or when the Bearer Token is destroyed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.StringType,
+			},
 			"metadata": getMetadataSchemaAttribute(),
 			// "labels":   getLabelsSchemaAttribute(),
 			// "annotations": getAnnotationsSchemaAttribute(),
@@ -324,6 +415,14 @@ func (r *BearerTokenResource) Update(ctx context.Context, req resource.UpdateReq
 
 	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_bearer_token with id '%s'", data.Id.ValueString()))
 
+	// An unknown id is planned when the Bearer Token must be rotated, other changes only affect provider settings
+	if data.Id.IsUnknown() {
+		rotateBearerToken(ctx, &resp.Diagnostics, r.client, data, stateData)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// jsonPayload := getBearerTokenJsonPayload(ctx, &resp.Diagnostics, data, "update")
 
 	// if resp.Diagnostics.HasError() {
@@ -359,6 +458,12 @@ func (r *BearerTokenResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The retired Bearer Tokens still valid during their rotation overlap expire on their own
+	deleteRotatedBearerTokens(ctx, &resp.Diagnostics, r.client, getSetStringJsonPayload(ctx, data.RotatedTokenIds))
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_bearer_token with id '%s'", data.Id.ValueString()))
 }
 
@@ -371,6 +476,147 @@ func (r *BearerTokenResource) ImportState(ctx context.Context, req resource.Impo
 	tflog.Debug(ctx, "End import of state resource: hyperfabric_bearer_token")
 }
 
+const requiresBearerTokenReplaceDescription = "The Bearer Token is replaced when rotation_overlap is not configured."
+
+// requiresBearerTokenReplace replaces the Bearer Token unless the change can be applied by a rotation with overlap.
+func requiresBearerTokenReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var rotationOverlap timetypes.GoDuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rotation_overlap"), &rotationOverlap)...)
+	resp.RequiresReplace = rotationOverlap.IsNull()
+}
+
+// isBearerTokenExpiring returns true when notAfter is within the rotateBefore duration from now.
+func isBearerTokenExpiring(ctx context.Context, diags *diag.Diagnostics, rotateBefore timetypes.GoDuration, notAfter timetypes.RFC3339) bool {
+	if rotateBefore.IsNull() || rotateBefore.IsUnknown() || notAfter.IsNull() || notAfter.IsUnknown() {
+		return false
+	}
+
+	rotateBeforeDuration, durationDiags := rotateBefore.ValueGoDuration()
+	notAfterTime, timeDiags := notAfter.ValueRFC3339Time()
+	diags.Append(durationDiags...)
+	diags.Append(timeDiags...)
+	if diags.HasError() {
+		return false
+	}
+	return !time.Now().Add(rotateBeforeDuration).Before(notAfterTime)
+}
+
+// rotateBearerToken creates a new Bearer Token before retiring the previous Bearer Token. Because the name identifies a
+// Bearer Token, the new Bearer Token is created with a temporary name and renamed once the previous Bearer Token has
+// been renamed to {name}-rotated-{timestamp}. The validity of the previous Bearer Token is shortened to the rotation
+// overlap, after which it expires and is deleted by a later rotation or when the resource is destroyed. The id of the
+// previous Bearer Token is tracked in the rotated_token_ids attribute.
+func rotateBearerToken(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data, stateData *BearerTokenResourceModel) {
+	rotationOverlap, durationDiags := data.RotationOverlap.ValueGoDuration()
+	diags.Append(durationDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	timestamp := time.Now().Unix()
+
+	// The replacement is created from the configuration, the computed validity of the previous Bearer Token is not reused
+	newToken := getNewBearerTokenResourceModelFromData(data)
+	newToken.Name = basetypes.NewStringValue(fmt.Sprintf("%s-rotating-%d", data.Name.ValueString(), timestamp))
+	jsonPayload := getBearerTokenJsonPayload(ctx, diags, newToken, "create")
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create Bearer Token '%s' to rotate Bearer Token '%s'", newToken.Name.ValueString(), stateData.Id.ValueString()))
+	container := DoRestRequest(ctx, diags, client, "/api/v1/bearerTokens", "POST", jsonPayload)
+	if diags.HasError() {
+		return
+	}
+
+	bearerTokensContainer, err := container.ArrayElement(0, "tokens")
+	if err != nil {
+		diags.AddError(
+			"Rotation of the Bearer Token failed",
+			fmt.Sprintf("The response does not contain the new Bearer Token '%s'. Err: %s", newToken.Name.ValueString(), err),
+		)
+		return
+	}
+	newTokenId := StripQuotes(bearerTokensContainer.Search("tokenId").String())
+	token := StripQuotes(container.Search("token").String())
+
+	previousToken := getNewBearerTokenResourceModelFromData(stateData)
+	retiredToken := getNewBearerTokenResourceModelFromData(stateData)
+	retiredToken.Name = basetypes.NewStringValue(fmt.Sprintf("%s-rotated-%d", stateData.Name.ValueString(), timestamp))
+	retiredNotAfter := time.Now().UTC().Add(rotationOverlap)
+	if notAfter, timeDiags := stateData.NotAfter.ValueRFC3339Time(); !timeDiags.HasError() && !stateData.NotAfter.IsNull() && notAfter.Before(retiredNotAfter) {
+		retiredNotAfter = notAfter
+	}
+	retiredToken.NotAfter = timetypes.NewRFC3339TimeValue(retiredNotAfter)
+
+	tflog.Debug(ctx, fmt.Sprintf("Retire Bearer Token '%s' as '%s' until '%s'", stateData.Id.ValueString(), retiredToken.Name.ValueString(), retiredToken.NotAfter.ValueString()))
+	DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", stateData.Id.ValueString()), "PUT", getBearerTokenJsonPayload(ctx, diags, retiredToken, "update"))
+	if diags.HasError() {
+		// Delete the new Bearer Token so the failed rotation does not leave an unmanaged Bearer Token behind
+		var cleanupDiags diag.Diagnostics
+		DoRestRequest(ctx, &cleanupDiags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", newTokenId), "DELETE", nil)
+		diags.Append(cleanupDiags...)
+		return
+	}
+
+	newToken.Id = basetypes.NewStringValue(newTokenId)
+	newToken.Name = data.Name
+	DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", newTokenId), "PUT", getBearerTokenJsonPayload(ctx, diags, newToken, "update"))
+	if diags.HasError() {
+		// Restore the previous Bearer Token so consumers are not affected by the failed rotation
+		var restoreDiags diag.Diagnostics
+		DoRestRequest(ctx, &restoreDiags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", newTokenId), "DELETE", nil)
+		DoRestRequest(ctx, &restoreDiags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", stateData.Id.ValueString()), "PUT", getBearerTokenJsonPayload(ctx, &restoreDiags, previousToken, "update"))
+		diags.Append(restoreDiags...)
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(newTokenId)
+	data.TokenId = basetypes.NewStringValue(newTokenId)
+	data.Token = basetypes.NewStringNull()
+	if token != "" {
+		data.Token = basetypes.NewStringValue(token)
+	}
+	rotatedTokenIds := append(getSetStringJsonPayload(ctx, stateData.RotatedTokenIds), stateData.Id.ValueString())
+	getAndSetBearerTokenAttributes(ctx, diags, client, data)
+	if diags.HasError() {
+		return
+	}
+
+	data.RotatedTokenIds = NewSetString(ctx, deleteRotatedBearerTokens(ctx, diags, client, rotatedTokenIds))
+}
+
+// deleteRotatedBearerTokens deletes the expired Bearer Tokens among the Bearer Tokens retired by the rotations and
+// returns the ids of the retired Bearer Tokens that are kept, which are still valid during their rotation overlap.
+// Retired Bearer Tokens that no longer exist are ignored.
+func deleteRotatedBearerTokens(ctx context.Context, diags *diag.Diagnostics, client *client.Client, rotatedTokenIds []string) []interface{} {
+	keptTokenIds := make([]interface{}, 0)
+	if len(rotatedTokenIds) == 0 {
+		return keptTokenIds
+	}
+
+	tokens := map[string]BearerTokenSummaryDataSourceModel{}
+	for _, token := range getBearerTokensList(ctx, diags, client, nil) {
+		tokens[token.Id.ValueString()] = token
+	}
+
+	for _, tokenId := range rotatedTokenIds {
+		token, found := tokens[tokenId]
+		if found && token.Expired.ValueBool() && !diags.HasError() {
+			tflog.Debug(ctx, fmt.Sprintf("Delete rotated Bearer Token '%s'", token.Name.ValueString()))
+			DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", tokenId), "DELETE", nil)
+			if !diags.HasError() {
+				continue
+			}
+		}
+		// After a failure, all the remaining ids are kept so they can be deleted later
+		if found || diags.HasError() {
+			keptTokenIds = append(keptTokenIds, tokenId)
+		}
+	}
+	return keptTokenIds
+}
+
 func getAndSetBearerTokenAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *BearerTokenResourceModel) {
 	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/bearerTokens/%s", data.Id.ValueString()), "GET", nil)
 	if diags.HasError() {
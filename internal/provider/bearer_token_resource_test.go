@@ -112,6 +112,22 @@ func TestAccBearerTokenResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hyperfabric_bearer_token.test", "name", name),
 				),
 			},
+			// Update with rotation overlap and verify the Bearer Token is rotated in-place.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: BearerToken - Update with rotation overlap and verify the Bearer Token is rotated in-place.")
+				},
+				Config:             testBearerTokenResourceHclConfig(name, "rotate"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_bearer_token.test", "name", name),
+					resource.TestCheckResourceAttr("hyperfabric_bearer_token.test", "description", "This bearer token has been rotated"),
+					resource.TestCheckResourceAttr("hyperfabric_bearer_token.test", "scope", "ADMIN"),
+					resource.TestCheckResourceAttr("hyperfabric_bearer_token.test", "rotate_before", "168h"),
+					resource.TestCheckResourceAttr("hyperfabric_bearer_token.test", "rotation_overlap", "1h"),
+					resource.TestCheckResourceAttrSet("hyperfabric_bearer_token.test", "token"),
+				),
+			},
 		},
 	})
 }
@@ -136,6 +152,16 @@ resource "hyperfabric_bearer_token" "test" {
     not_before  = "2024-09-03T08:00:00.000Z"
     scope       = "ADMIN"
 }
+`, name)
+	} else if configType == "rotate" {
+		return fmt.Sprintf(`
+resource "hyperfabric_bearer_token" "test" {
+	name             = "%[1]s"
+	description      = "This bearer token has been rotated"
+	scope            = "ADMIN"
+	rotate_before    = "168h"
+	rotation_overlap = "1h"
+}
 `, name)
 	} else if configType == "minimal+" {
 		return fmt.Sprintf(`
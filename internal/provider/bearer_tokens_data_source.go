@@ -0,0 +1,247 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BearerTokensDataSource{}
+
+func NewBearerTokensDataSource() datasource.DataSource {
+	return &BearerTokensDataSource{}
+}
+
+// BearerTokensDataSource defines the data source implementation.
+type BearerTokensDataSource struct {
+	client *client.Client
+}
+
+// BearerTokensDataSourceModel describes the data source data model.
+type BearerTokensDataSourceModel struct {
+	Id             types.String         `tfsdk:"id"`
+	ExpiringWithin timetypes.GoDuration `tfsdk:"expiring_within"`
+	Tokens         types.List           `tfsdk:"tokens"`
+}
+
+// BearerTokenSummaryDataSourceModel describes a Bearer Token in the list of Bearer Tokens.
+type BearerTokenSummaryDataSourceModel struct {
+	Id          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	NotAfter    timetypes.RFC3339 `tfsdk:"not_after"`
+	NotBefore   timetypes.RFC3339 `tfsdk:"not_before"`
+	Scope       types.String      `tfsdk:"scope"`
+	Expired     types.Bool        `tfsdk:"expired"`
+}
+
+func BearerTokenSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":          types.StringType,
+			"name":        types.StringType,
+			"description": types.StringType,
+			"not_after":   timetypes.RFC3339Type{},
+			"not_before":  timetypes.RFC3339Type{},
+			"scope":       types.StringType,
+			"expired":     types.BoolType,
+		},
+	}
+}
+
+func (d *BearerTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_bearer_tokens")
+	resp.TypeName = req.ProviderTypeName + "_bearer_tokens"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_bearer_tokens")
+}
+
+func (d *BearerTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_bearer_tokens")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Bearer Tokens data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to `bearerTokens`.",
+				Computed:            true,
+			},
+			"expiring_within": schema.StringAttribute{
+				MarkdownDescription: "A duration (i.e. `720h`) to only return the Bearer Tokens expiring within this duration from now, including the expired Bearer Tokens.",
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+			},
+			"tokens": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Bearer Tokens sorted by expiry.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Bearer Token.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Bearer Token.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the Bearer Token.",
+							Computed:            true,
+						},
+						"not_after": schema.StringAttribute{
+							MarkdownDescription: "The end date for the validity of the Bearer Token.",
+							Computed:            true,
+							CustomType:          timetypes.RFC3339Type{},
+						},
+						"not_before": schema.StringAttribute{
+							MarkdownDescription: "The start date for the validity of the Bearer Token.",
+							Computed:            true,
+							CustomType:          timetypes.RFC3339Type{},
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "The scope assigned to the Bearer Token.",
+							Computed:            true,
+						},
+						"expired": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Bearer Token has already expired.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_bearer_tokens")
+}
+
+func (d *BearerTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_bearer_tokens")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_bearer_tokens")
+}
+
+func (d *BearerTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_bearer_tokens")
+	var data *BearerTokensDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue("bearerTokens")
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_bearer_tokens expiring within '%s'", data.ExpiringWithin.ValueString()))
+
+	var expiringBefore *time.Time
+	if !data.ExpiringWithin.IsNull() && !data.ExpiringWithin.IsUnknown() {
+		expiringWithin, diags := data.ExpiringWithin.ValueGoDuration()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		expiringBeforeTime := time.Now().Add(expiringWithin)
+		expiringBefore = &expiringBeforeTime
+	}
+
+	tokens := getBearerTokensList(ctx, &resp.Diagnostics, d.client, expiringBefore)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tokens, _ = types.ListValueFrom(ctx, BearerTokenSummaryDataSourceModelAttributeType(), tokens)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_bearer_tokens with id '%s'", data.Id.ValueString()))
+}
+
+// getBearerTokensList returns the Bearer Tokens sorted by expiry. When expiringBefore is set, only the Bearer Tokens
+// without a validity end date after expiringBefore are returned.
+func getBearerTokensList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, expiringBefore *time.Time) []BearerTokenSummaryDataSourceModel {
+	tokens := make([]BearerTokenSummaryDataSourceModel, 0)
+	requestData := DoRestRequest(ctx, diags, client, "/api/v1/bearerTokens", "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return tokens
+	}
+
+	responseMap, _ := requestData.Data().(map[string]interface{})
+	tokenList, _ := responseMap["tokens"].([]interface{})
+	now := time.Now()
+	for _, token := range tokenList {
+		tokenMap, ok := token.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		notAfter, _ := timetypes.NewRFC3339Value(getStringFromMap(tokenMap, "notAfter"))
+		notBefore, _ := timetypes.NewRFC3339Value(getStringFromMap(tokenMap, "notBefore"))
+		notAfterTime, timeDiags := notAfter.ValueRFC3339Time()
+		if timeDiags.HasError() || getStringFromMap(tokenMap, "notAfter") == "" {
+			notAfter = timetypes.NewRFC3339Null()
+			notAfterTime = time.Time{}
+		}
+		if getStringFromMap(tokenMap, "notBefore") == "" {
+			notBefore = timetypes.NewRFC3339Null()
+		}
+
+		if expiringBefore != nil && (notAfter.IsNull() || notAfterTime.After(*expiringBefore)) {
+			continue
+		}
+
+		tokens = append(tokens, BearerTokenSummaryDataSourceModel{
+			Id:          basetypes.NewStringValue(getStringFromMap(tokenMap, "tokenId")),
+			Name:        basetypes.NewStringValue(getStringFromMap(tokenMap, "name")),
+			Description: basetypes.NewStringValue(getStringFromMap(tokenMap, "description")),
+			NotAfter:    notAfter,
+			NotBefore:   notBefore,
+			Scope:       basetypes.NewStringValue(strings.TrimPrefix(getStringFromMap(tokenMap, "scope"), "TOKEN_SCOPE_")),
+			Expired:     basetypes.NewBoolValue(!notAfter.IsNull() && notAfterTime.Before(now)),
+		})
+	}
+
+	// Bearer Tokens without a validity end date are sorted last
+	sort.SliceStable(tokens, func(i, j int) bool {
+		if tokens[i].NotAfter.IsNull() || tokens[j].NotAfter.IsNull() {
+			return !tokens[i].NotAfter.IsNull()
+		}
+		notAfterI, _ := tokens[i].NotAfter.ValueRFC3339Time()
+		notAfterJ, _ := tokens[j].NotAfter.ValueRFC3339Time()
+		return notAfterI.Before(notAfterJ)
+	})
+	return tokens
+}
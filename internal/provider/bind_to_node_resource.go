@@ -10,14 +10,18 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,6 +30,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BindToNodeResource{}
 var _ resource.ResourceWithImportState = &BindToNodeResource{}
+var _ resource.ResourceWithModifyPlan = &BindToNodeResource{}
 
 func NewBindToNodeResource() resource.Resource {
 	return &BindToNodeResource{}
@@ -38,16 +43,23 @@ type BindToNodeResource struct {
 
 // BindToNodeResourceModel describes the resource data model.
 type BindToNodeResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	NodeId   types.String `tfsdk:"node_id"`
-	DeviceId types.String `tfsdk:"device_id"`
+	Id           types.String         `tfsdk:"id"`
+	NodeId       types.String         `tfsdk:"node_id"`
+	DeviceId     types.String         `tfsdk:"device_id"`
+	SerialNumber types.String         `tfsdk:"serial_number"`
+	WaitTimeout  timetypes.GoDuration `tfsdk:"wait_timeout"`
 }
 
+// bindToNodeDevicePollInterval is the interval between two lookups of the Device in the inventory when waiting for it.
+const bindToNodeDevicePollInterval = 15 * time.Second
+
 func getEmptyBindToNodeResourceModel() *BindToNodeResourceModel {
 	return &BindToNodeResourceModel{
-		Id:       basetypes.NewStringNull(),
-		NodeId:   basetypes.NewStringNull(),
-		DeviceId: basetypes.NewStringNull(),
+		Id:           basetypes.NewStringNull(),
+		NodeId:       basetypes.NewStringNull(),
+		DeviceId:     basetypes.NewStringNull(),
+		SerialNumber: basetypes.NewStringNull(),
+		WaitTimeout:  timetypes.NewGoDurationNull(),
 	}
 }
 
@@ -66,6 +78,14 @@ func getNewBindToNodeResourceModelFromData(data *BindToNodeResourceModel) *BindT
 		newBindToNode.DeviceId = data.DeviceId
 	}
 
+	if !data.SerialNumber.IsNull() && !data.SerialNumber.IsUnknown() {
+		newBindToNode.SerialNumber = data.SerialNumber
+	}
+
+	if !data.WaitTimeout.IsNull() && !data.WaitTimeout.IsUnknown() {
+		newBindToNode.WaitTimeout = data.WaitTimeout
+	}
+
 	return newBindToNode
 }
 
@@ -101,17 +121,77 @@ func (r *BindToNodeResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"device_id": schema.StringAttribute{
-				MarkdownDescription: "`device_id` defines the unique identifier of a Device.",
-				Required:            true,
+				MarkdownDescription: "`device_id` defines the unique identifier of a Device. When not provided, the Device is found in the inventory using the `serial_number`. Changing the Device rebinds the Node in place.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("serial_number")),
 				},
 			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "The serial number of the Device to bind to the Node. When neither `device_id` nor `serial_number` are provided, the serial number of the Node is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "A duration (i.e. `30m`) to wait for the Device with the serial number to appear in the inventory before binding it to the Node. When not provided, the Device must already be in the inventory.",
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+			},
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_bind_to_node")
 }
 
+func (r *BindToNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		var planData, stateData, configData *BindToNodeResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The Device and its serial number are kept from the state by UseStateForUnknown, so the one which is not
+		// configured must be recomputed when the other one is changed to rebind the Node to another Device.
+		if configData.DeviceId.IsNull() && !planData.SerialNumber.IsUnknown() && !planData.SerialNumber.Equal(stateData.SerialNumber) {
+			planData.DeviceId = basetypes.NewStringUnknown()
+		}
+		if configData.SerialNumber.IsNull() && !planData.DeviceId.IsNull() && !planData.DeviceId.Equal(stateData.DeviceId) {
+			planData.SerialNumber = basetypes.NewStringUnknown()
+		}
+
+		// Verify the replacement Device during plan. A Device selected by its serial number is only verified when it is
+		// already in the inventory, otherwise the binding is verified by the API during apply.
+		if r.client != nil && !planData.NodeId.IsUnknown() {
+			replacementData := *planData
+			if replacementData.DeviceId.IsUnknown() && !replacementData.SerialNumber.IsUnknown() && !replacementData.SerialNumber.IsNull() {
+				replacementData.DeviceId = basetypes.NewStringNull()
+				for _, device := range getDevicesList(ctx, &resp.Diagnostics, r.client) {
+					if device.SerialNumber.ValueString() == replacementData.SerialNumber.ValueString() {
+						replacementData.DeviceId = device.DeviceId
+					}
+				}
+			}
+			if !replacementData.DeviceId.IsNull() && !replacementData.DeviceId.IsUnknown() && !replacementData.DeviceId.Equal(stateData.DeviceId) {
+				checkBindToNodeReplacementDevice(ctx, &resp.Diagnostics, r.client, &replacementData)
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+	}
+}
+
 func (r *BindToNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: hyperfabric_bind_to_node")
 	// Prevent panic if the provider has not been configured.
@@ -145,6 +225,13 @@ func (r *BindToNodeResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if data.DeviceId.IsNull() || data.DeviceId.IsUnknown() {
+		data.DeviceId = basetypes.NewStringValue(getBindToNodeDeviceIdFromSerialNumber(ctx, &resp.Diagnostics, r.client, data))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_bind_to_node with NodeId '%s' and DeviceId '%s'", data.NodeId.ValueString(), data.DeviceId.ValueString()))
 
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/devices/%s", data.NodeId.ValueString(), data.DeviceId.ValueString()), "PUT", nil)
@@ -201,6 +288,35 @@ func (r *BindToNodeResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_bind_to_node with id '%s'", data.Id.ValueString()))
 
+	if data.DeviceId.IsNull() || data.DeviceId.IsUnknown() {
+		data.DeviceId = basetypes.NewStringValue(getBindToNodeDeviceIdFromSerialNumber(ctx, &resp.Diagnostics, r.client, data))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.DeviceId.ValueString() != stateData.DeviceId.ValueString() {
+		// The replacement Device is verified by checkBindToNodeReplacementDevice during plan
+		if data.SerialNumber.IsNull() || data.SerialNumber.IsUnknown() {
+			device := getEmptyDeviceDataSourceModel()
+			device.DeviceId = data.DeviceId
+			getAndSetDeviceAttributes(ctx, &resp.Diagnostics, r.client, device)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.SerialNumber = device.SerialNumber
+		}
+
+		// The Node is bound to the replacement Device without being unbound first to preserve its operational history
+		DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/devices/%s", data.NodeId.ValueString(), data.DeviceId.ValueString()), "PUT", nil)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/devices/%s", data.NodeId.ValueString(), data.DeviceId.ValueString()))
+	getAndSetBindToNodeAttributes(ctx, &resp.Diagnostics, r.client, data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_bind_to_node with id '%s'", data.Id.ValueString()))
@@ -259,6 +375,9 @@ func getAndSetBindToNodeAttributes(ctx context.Context, diags *diag.Diagnostics,
 	if !newNode.Id.IsNull() && !newNode.FabricId.IsUnknown() {
 		newBindToNode.NodeId = newNode.Id
 		newBindToNode.DeviceId = newNode.DeviceId
+		if newBindToNode.SerialNumber.IsNull() && newNode.SerialNumber.ValueString() != "" {
+			newBindToNode.SerialNumber = newNode.SerialNumber
+		}
 		newBindToNode.Id = basetypes.NewStringValue(fmt.Sprintf("%s/devices/%s", newBindToNode.NodeId.ValueString(), newBindToNode.DeviceId.ValueString()))
 	} else {
 		newBindToNode.Id = basetypes.NewStringNull()
@@ -266,6 +385,108 @@ func getAndSetBindToNodeAttributes(ctx context.Context, diags *diag.Diagnostics,
 	*data = newBindToNode
 }
 
+// getBindToNodeDeviceIdFromSerialNumber returns the identifier of the Device with the serial number of the resource or
+// of the Node, waiting up to the wait_timeout for the Device to appear in the inventory.
+func getBindToNodeDeviceIdFromSerialNumber(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *BindToNodeResourceModel) string {
+	if data.SerialNumber.IsNull() || data.SerialNumber.IsUnknown() || data.SerialNumber.ValueString() == "" {
+		node := getEmptyNodeResourceModel()
+		node.Id = data.NodeId
+		checkAndSetNodeIds(node)
+		getAndSetNodeAttributes(ctx, diags, client, node)
+		if diags.HasError() {
+			return ""
+		}
+		if node.SerialNumber.ValueString() == "" {
+			diags.AddError(
+				"Missing Device Serial Number",
+				fmt.Sprintf("Either device_id or serial_number must be provided when the Node '%s' has no serial_number.", data.NodeId.ValueString()),
+			)
+			return ""
+		}
+		data.SerialNumber = node.SerialNumber
+	}
+
+	var waitTimeout time.Duration
+	if !data.WaitTimeout.IsNull() && !data.WaitTimeout.IsUnknown() {
+		var durationDiags diag.Diagnostics
+		waitTimeout, durationDiags = data.WaitTimeout.ValueGoDuration()
+		diags.Append(durationDiags...)
+		if diags.HasError() {
+			return ""
+		}
+	}
+
+	deadline := time.Now().Add(waitTimeout)
+	for {
+		for _, device := range getDevicesList(ctx, diags, client) {
+			if device.SerialNumber.ValueString() == data.SerialNumber.ValueString() {
+				return device.DeviceId.ValueString()
+			}
+		}
+		if diags.HasError() {
+			return ""
+		}
+
+		if !time.Now().Before(deadline) {
+			diags.AddError(
+				"Device Not Found",
+				fmt.Sprintf("The Device with serial number '%s' has not been found in the inventory within '%s'.", data.SerialNumber.ValueString(), waitTimeout),
+			)
+			return ""
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for the Device with serial number '%s' to appear in the inventory", data.SerialNumber.ValueString()))
+		select {
+		case <-ctx.Done():
+			diags.AddError(
+				"Device Not Found",
+				fmt.Sprintf("Waiting for the Device with serial number '%s' has been interrupted: %s", data.SerialNumber.ValueString(), ctx.Err()),
+			)
+			return ""
+		case <-time.After(min(bindToNodeDevicePollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// checkBindToNodeReplacementDevice verifies during plan that the Device replacing the Device bound to the Node exists, is
+// not bound to a Node and has the same model as the Node.
+func checkBindToNodeReplacementDevice(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *BindToNodeResourceModel) {
+	node := getEmptyNodeResourceModel()
+	node.Id = data.NodeId
+	checkAndSetNodeIds(node)
+	getAndSetNodeAttributes(ctx, diags, client, node)
+	if diags.HasError() {
+		return
+	}
+
+	device := getEmptyDeviceDataSourceModel()
+	device.DeviceId = data.DeviceId
+	getAndSetDeviceAttributes(ctx, diags, client, device)
+	if diags.HasError() {
+		return
+	}
+
+	if device.Id.IsNull() {
+		diags.AddAttributeError(
+			path.Root("device_id"),
+			"Device Not Found",
+			fmt.Sprintf("The Device '%s' has not been found in the inventory.", data.DeviceId.ValueString()),
+		)
+	} else if device.ModelName.ValueString() != node.ModelName.ValueString() {
+		diags.AddAttributeError(
+			path.Root("device_id"),
+			"Device Model Mismatch",
+			fmt.Sprintf("The Device '%s' of model '%s' cannot be bound to the Node '%s' of model '%s'.", data.DeviceId.ValueString(), device.ModelName.ValueString(), node.Name.ValueString(), node.ModelName.ValueString()),
+		)
+	} else if device.NodeId.ValueString() != "" {
+		diags.AddAttributeError(
+			path.Root("device_id"),
+			"Device Already Bound",
+			fmt.Sprintf("The Device '%s' is already bound to the Node '%s' and must be unbound before being bound to the Node '%s'.", data.DeviceId.ValueString(), device.NodeId.ValueString(), node.Name.ValueString()),
+		)
+	}
+}
+
 func checkAndSetBindToNodeIds(data *BindToNodeResourceModel) {
 	if strings.Contains(data.Id.ValueString(), "/devices/") {
 		if data.NodeId.IsNull() || data.NodeId.IsUnknown() || data.NodeId.ValueString() == "" || data.DeviceId.IsNull() || data.DeviceId.IsUnknown() || data.DeviceId.ValueString() == "" {
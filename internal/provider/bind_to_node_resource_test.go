@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccBindToNodeResource(t *testing.T) {
@@ -129,3 +130,103 @@ resource "hyperfabric_bind_to_node" "test" {
 }
 `, fabricName, deviceId)
 }
+
+func TestAccBindToNodeResourceWithSerialNumber(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	serialNumber := getStringAttribute(basetypes.NewStringNull(), "TF_ACC_HYPERFABRIC_DEVICE_SERIAL_NUMBER", "")
+	if serialNumber == "" {
+		t.Skip("Missing serialNumber for test. Please configure environment variable TF_ACC_HYPERFABRIC_DEVICE_SERIAL_NUMBER with the serial number of an unbound Device.")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the serial number of the Node and verify the Device is found in the inventory.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Bind To Node - Create with the serial number of the Node and verify the Device is found in the inventory.")
+				},
+				Config:             testBindToNodeResourceSerialNumberHclConfig(fabricName, serialNumber),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hyperfabric_bind_to_node.test", "node_id"),
+					resource.TestCheckResourceAttrSet("hyperfabric_bind_to_node.test", "device_id"),
+					resource.TestCheckResourceAttr("hyperfabric_bind_to_node.test", "serial_number", serialNumber),
+					resource.TestCheckResourceAttr("hyperfabric_bind_to_node.test", "wait_timeout", "1m"),
+				),
+			},
+			// Run Plan Only with the same config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Bind To Node - Run Plan Only with the same config and check that plan is empty.")
+				},
+				Config:             testBindToNodeResourceSerialNumberHclConfig(fabricName, serialNumber),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testBindToNodeResourceSerialNumberHclConfig(fabricName string, serialNumber string) string {
+	return fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id     = hyperfabric_fabric.test.id
+	name          = "node1"
+	model_name    = "HF6100-32D"
+	roles         = ["LEAF"]
+	serial_number = "%[2]s"
+}
+
+resource "hyperfabric_bind_to_node" "test" {
+	node_id      = hyperfabric_node.test.id
+	wait_timeout = "1m"
+}
+`, fabricName, serialNumber)
+}
+
+func TestAccBindToNodeResourceRebind(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	deviceId := getStringAttribute(basetypes.NewStringNull(), "TF_ACC_HYPERFABRIC_DEVICE_ID", "")
+	replacementDeviceId := getStringAttribute(basetypes.NewStringNull(), "TF_ACC_HYPERFABRIC_REPLACEMENT_DEVICE_ID", "")
+	if deviceId == "" || replacementDeviceId == "" {
+		t.Skip("Missing deviceIds for test. Please configure environment variables TF_ACC_HYPERFABRIC_DEVICE_ID and TF_ACC_HYPERFABRIC_REPLACEMENT_DEVICE_ID with two unbound Devices of the same model.")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the first Device.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Bind To Node - Create with the first Device.")
+				},
+				Config:             testBindToNodeResourceHclConfig(fabricName, deviceId, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_bind_to_node.test", "device_id", deviceId),
+				),
+			},
+			// Update with the replacement Device and verify the Node is rebound in place.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Bind To Node - Update with the replacement Device and verify the Node is rebound in place.")
+				},
+				Config: testBindToNodeResourceHclConfig(fabricName, replacementDeviceId, "minimal"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("hyperfabric_bind_to_node.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_bind_to_node.test", "device_id", replacementDeviceId),
+				),
+			},
+		},
+	})
+}
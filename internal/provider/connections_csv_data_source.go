@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectionsCsvDataSource{}
+
+func NewConnectionsCsvDataSource() datasource.DataSource {
+	return &ConnectionsCsvDataSource{}
+}
+
+// ConnectionsCsvDataSource defines the data source implementation.
+type ConnectionsCsvDataSource struct {
+	client *client.Client
+}
+
+// ConnectionsCsvDataSourceModel describes the data source data model.
+type ConnectionsCsvDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	FabricId types.String `tfsdk:"fabric_id"`
+	NodeId   types.String `tfsdk:"node_id"`
+	Content  types.String `tfsdk:"content"`
+}
+
+func (d *ConnectionsCsvDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_connections_csv")
+	resp.TypeName = req.ProviderTypeName + "_connections_csv"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_connections_csv")
+}
+
+func (d *ConnectionsCsvDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_connections_csv")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Connections CSV data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/connectionsCsv`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "The ID or the name of a Node to only export the Connections with the Node on the local or the remote side.",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The Connections of the Fabric as a CSV list sorted by local Node name and port name, with the `cable_label`, `local_node`, `local_port`, `remote_node`, `remote_port`, `pluggable` and `description` columns.",
+				Computed:            true,
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_connections_csv")
+}
+
+func (d *ConnectionsCsvDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_connections_csv")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_connections_csv")
+}
+
+func (d *ConnectionsCsvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_connections_csv")
+	var data *ConnectionsCsvDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/connectionsCsv", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+
+	connections := getConnectionsList(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString(), data.NodeId.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Content = basetypes.NewStringValue(renderConnectionsCsv(getConnectionsCsvRowsFromSummaries(ctx, connections), true))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+}
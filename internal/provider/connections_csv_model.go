@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var connectionsCsvColumns = []string{"local_node", "local_port", "remote_node", "remote_port", "pluggable", "description"}
+
+// ConnectionsCsvRow describes a Connection in a CSV list of Connections. The local and remote Node can be referenced
+// by name or by ID.
+type ConnectionsCsvRow struct {
+	Line         int
+	ConnectionId string
+	LocalNode    string
+	LocalNodeId  string
+	LocalPort    string
+	RemoteNode   string
+	RemoteNodeId string
+	RemotePort   string
+	Pluggable    string
+	Description  string
+}
+
+// key returns the local Node and port of the Connection, which uniquely identifies a row in a CSV list of Connections.
+func (row ConnectionsCsvRow) key() string {
+	return fmt.Sprintf("%s:%s", row.LocalNode, row.LocalPort)
+}
+
+// cableLabel returns the label of the cable used for the Connection.
+func (row ConnectionsCsvRow) cableLabel() string {
+	return fmt.Sprintf("%s:%s <> %s:%s", row.LocalNode, row.LocalPort, row.RemoteNode, row.RemotePort)
+}
+
+// matches returns true when the row describes the same Connection as the other row. When strictPluggable is false, an
+// empty pluggable of the row matches any pluggable of the other row, such as the pluggable selected by the API when the
+// pluggable is not configured.
+func (row ConnectionsCsvRow) matches(other ConnectionsCsvRow, strictPluggable bool) bool {
+	return connectionsCsvNodeMatches(row.LocalNode, other.LocalNode, other.LocalNodeId) && row.LocalPort == other.LocalPort &&
+		connectionsCsvNodeMatches(row.RemoteNode, other.RemoteNode, other.RemoteNodeId) && row.RemotePort == other.RemotePort &&
+		(row.Pluggable == other.Pluggable || (!strictPluggable && row.Pluggable == "")) &&
+		row.Description == other.Description
+}
+
+// connectionsCsvNodeMatches returns true when the Node reference is the name or the ID of the Node.
+func connectionsCsvNodeMatches(node, nodeName, nodeId string) bool {
+	node = getConnectionsCsvNodeReference(node)
+	return node == nodeName || (nodeId != "" && node == getConnectionsCsvNodeReference(nodeId))
+}
+
+// getConnectionsCsvNodeReference returns the name or the ID of a Node reference, which can also be provided as the id
+// of a hyperfabric_node resource ({fabricId}/nodes/{nodeId}).
+func getConnectionsCsvNodeReference(node string) string {
+	if _, nodeId := splitNodeId(node); nodeId != "" {
+		return nodeId
+	}
+	return node
+}
+
+// parseConnectionsCsv returns the rows of a CSV list of Connections. The first line of the CSV is a header which
+// must contain the local_node, local_port, remote_node and remote_port columns and can contain the pluggable and
+// description columns. Other columns (i.e. the cable_label column of an export) and lines starting with # are ignored.
+func parseConnectionsCsv(content string) ([]ConnectionsCsvRow, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("missing header line")
+	} else if err != nil {
+		return nil, err
+	}
+	// Spreadsheet exports often start with a UTF-8 byte order mark
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	columns := map[string]int{}
+	for index, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = index
+	}
+	for _, column := range connectionsCsvColumns[:4] {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing %s column in header line", column)
+		}
+	}
+
+	rows := []ConnectionsCsvRow{}
+	endpoints := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		value := func(column string) string {
+			if index, ok := columns[column]; ok {
+				return strings.TrimSpace(record[index])
+			}
+			return ""
+		}
+		row := ConnectionsCsvRow{
+			Line:        line,
+			LocalNode:   value("local_node"),
+			LocalPort:   value("local_port"),
+			RemoteNode:  value("remote_node"),
+			RemotePort:  value("remote_port"),
+			Pluggable:   value("pluggable"),
+			Description: value("description"),
+		}
+		for _, endpoint := range [][2]string{{row.LocalNode, row.LocalPort}, {row.RemoteNode, row.RemotePort}} {
+			if endpoint[0] == "" {
+				return nil, fmt.Errorf("line %d: missing node", line)
+			}
+			if !isValidPortName(endpoint[1]) {
+				return nil, fmt.Errorf("line %d: invalid port name (i.e. Ethernet1_10 or Ethernet1_1_1), got: %q", line, endpoint[1])
+			}
+			endpointKey := fmt.Sprintf("%s:%s", endpoint[0], endpoint[1])
+			if previousLine, ok := endpoints[endpointKey]; ok {
+				return nil, fmt.Errorf("line %d: port %s is already used on line %d", line, endpointKey, previousLine)
+			}
+			endpoints[endpointKey] = line
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// renderConnectionsCsv returns the rows sorted by local Node and port as a CSV list of Connections. When
+// withCableLabels is true, a cable_label column is added as first column.
+func renderConnectionsCsv(rows []ConnectionsCsvRow, withCableLabels bool) string {
+	rows = append([]ConnectionsCsvRow{}, rows...)
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].LocalNode != rows[j].LocalNode {
+			return rows[i].LocalNode < rows[j].LocalNode
+		}
+		return rows[i].LocalPort < rows[j].LocalPort
+	})
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	header := connectionsCsvColumns
+	if withCableLabels {
+		header = append([]string{"cable_label"}, header...)
+	}
+	_ = writer.Write(header)
+	for _, row := range rows {
+		record := []string{row.LocalNode, row.LocalPort, row.RemoteNode, row.RemotePort, row.Pluggable, row.Description}
+		if withCableLabels {
+			record = append([]string{row.cableLabel()}, record...)
+		}
+		_ = writer.Write(record)
+	}
+	writer.Flush()
+	return buffer.String()
+}
+
+// getConnectionsCsvRowsFromSummaries returns the Connections of a Fabric as rows of a CSV list of Connections.
+func getConnectionsCsvRowsFromSummaries(ctx context.Context, connections []ConnectionSummaryDataSourceModel) []ConnectionsCsvRow {
+	rows := []ConnectionsCsvRow{}
+	for _, connection := range connections {
+		local := NewLocalRemoteConnectionResourceModel(nil)
+		connection.Local.As(ctx, &local, basetypes.ObjectAsOptions{})
+		remote := NewLocalRemoteConnectionResourceModel(nil)
+		connection.Remote.As(ctx, &remote, basetypes.ObjectAsOptions{})
+		rows = append(rows, ConnectionsCsvRow{
+			ConnectionId: connection.ConnectionId.ValueString(),
+			LocalNode:    local.NodeName.ValueString(),
+			LocalNodeId:  local.NodeId.ValueString(),
+			LocalPort:    local.PortName.ValueString(),
+			RemoteNode:   remote.NodeName.ValueString(),
+			RemoteNodeId: remote.NodeId.ValueString(),
+			RemotePort:   remote.PortName.ValueString(),
+			Pluggable:    connection.Pluggable.ValueString(),
+			Description:  connection.Description.ValueString(),
+		})
+	}
+	return rows
+}
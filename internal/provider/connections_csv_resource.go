@@ -0,0 +1,433 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConnectionsCsvResource{}
+var _ resource.ResourceWithImportState = &ConnectionsCsvResource{}
+
+func NewConnectionsCsvResource() resource.Resource {
+	return &ConnectionsCsvResource{}
+}
+
+// ConnectionsCsvResource defines the resource implementation.
+type ConnectionsCsvResource struct {
+	client *client.Client
+}
+
+// ConnectionsCsvResourceModel describes the resource data model.
+type ConnectionsCsvResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	FabricId      types.String `tfsdk:"fabric_id"`
+	Content       types.String `tfsdk:"content"`
+	ConnectionIds types.Map    `tfsdk:"connection_ids"`
+}
+
+func (r *ConnectionsCsvResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_connections_csv")
+	resp.TypeName = req.ProviderTypeName + "_connections_csv"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_connections_csv")
+}
+
+func (r *ConnectionsCsvResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_connections_csv")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Connections CSV resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/connectionsCsv`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The CSV list of Connections managed by the resource. The header line must contain the `local_node`, `local_port`, `remote_node` and `remote_port` columns and can contain the `pluggable` and `description` columns. Nodes are referenced by name or by ID.",
+				Required:            true,
+				Validators: []validator.String{
+					IsConnectionsCsv(),
+				},
+			},
+			"connection_ids": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the Connections managed by the resource, keyed by the local Node and port of the Connection (i.e. `leaf1:Ethernet1_1`).",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_connections_csv")
+}
+
+func (r *ConnectionsCsvResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_connections_csv")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_connections_csv")
+}
+
+func (r *ConnectionsCsvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_connections_csv")
+
+	var data *ConnectionsCsvResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/connectionsCsv", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+
+	rows, err := parseConnectionsCsv(data.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Connections CSV", err.Error())
+		return
+	}
+
+	connectionIds := createConnectionsCsvRows(ctx, &resp.Diagnostics, r.client, data.FabricId.ValueString(), rows)
+	data.ConnectionIds, _ = types.MapValueFrom(ctx, types.StringType, connectionIds)
+	if resp.Diagnostics.HasError() {
+		// Save the Connections which were created to ensure they are deleted with the resource
+		if len(connectionIds) > 0 {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+
+	getAndSetConnectionsCsvAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+}
+
+func (r *ConnectionsCsvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_connections_csv")
+	var data *ConnectionsCsvResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+	checkAndSetConnectionsCsvIds(data)
+	getAndSetConnectionsCsvAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+}
+
+func (r *ConnectionsCsvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_connections_csv")
+	var data *ConnectionsCsvResourceModel
+	var stateData *ConnectionsCsvResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+
+	rows, err := parseConnectionsCsv(data.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Connections CSV", err.Error())
+		return
+	}
+	// The content of the state can be invalid when it was changed outside of Terraform
+	stateRows, _ := parseConnectionsCsv(stateData.Content.ValueString())
+	stateConnectionIds := map[string]string{}
+	stateData.ConnectionIds.ElementsAs(ctx, &stateConnectionIds, false)
+
+	// Connections cannot be modified, a changed row is replaced by a new Connection
+	connectionIds := map[string]string{}
+	unchangedRows := map[string]bool{}
+	for _, stateRow := range stateRows {
+		for _, row := range rows {
+			if row.key() == stateRow.key() && row.matches(stateRow, true) && stateConnectionIds[row.key()] != "" {
+				connectionIds[row.key()] = stateConnectionIds[row.key()]
+				unchangedRows[row.key()] = true
+			}
+		}
+	}
+
+	// Delete the Connections of the removed and changed rows first to release their ports
+	for key, connectionId := range stateConnectionIds {
+		if !unchangedRows[key] {
+			DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/connections/%s", data.FabricId.ValueString(), connectionId), "DELETE", nil)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	newRows := []ConnectionsCsvRow{}
+	for _, row := range rows {
+		if !unchangedRows[row.key()] {
+			newRows = append(newRows, row)
+		}
+	}
+	for key, connectionId := range createConnectionsCsvRows(ctx, &resp.Diagnostics, r.client, data.FabricId.ValueString(), newRows) {
+		connectionIds[key] = connectionId
+	}
+	data.ConnectionIds, _ = types.MapValueFrom(ctx, types.StringType, connectionIds)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	getAndSetConnectionsCsvAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+}
+
+func (r *ConnectionsCsvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_connections_csv")
+	var data *ConnectionsCsvResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+	connectionIds := map[string]string{}
+	data.ConnectionIds.ElementsAs(ctx, &connectionIds, false)
+	for _, connectionId := range connectionIds {
+		DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/connections/%s", data.FabricId.ValueString(), connectionId), "DELETE", nil)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_connections_csv with id '%s'", data.Id.ValueString()))
+}
+
+func (r *ConnectionsCsvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_connections_csv")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	var stateData *ConnectionsCsvResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_connections_csv with id '%s'", stateData.Id.ValueString()))
+
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_connections_csv")
+}
+
+// getAndSetConnectionsCsvAttributes refreshes the Connections managed by the resource. When the resource is imported,
+// all the Connections of the Fabric are adopted. The content is rendered again from the Connections of the Fabric
+// when a Connection was deleted or modified outside of Terraform.
+func getAndSetConnectionsCsvAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ConnectionsCsvResourceModel) {
+	connections := getConnectionsList(ctx, diags, client, data.FabricId.ValueString(), "")
+	if diags.HasError() {
+		return
+	}
+	fabricRows := getConnectionsCsvRowsFromSummaries(ctx, connections)
+
+	managedRows := []ConnectionsCsvRow{}
+	connectionIds := map[string]string{}
+	if data.ConnectionIds.IsNull() || data.ConnectionIds.IsUnknown() {
+		managedRows = fabricRows
+		for _, row := range fabricRows {
+			connectionIds[row.key()] = row.ConnectionId
+		}
+	} else {
+		stateConnectionIds := map[string]string{}
+		data.ConnectionIds.ElementsAs(ctx, &stateConnectionIds, false)
+		for _, row := range fabricRows {
+			for key, connectionId := range stateConnectionIds {
+				if row.ConnectionId == connectionId {
+					managedRows = append(managedRows, row)
+					connectionIds[key] = connectionId
+				}
+			}
+		}
+	}
+	data.ConnectionIds, _ = types.MapValueFrom(ctx, types.StringType, connectionIds)
+
+	rows, err := parseConnectionsCsv(data.Content.ValueString())
+	if err == nil && len(rows) == len(managedRows) {
+		unchanged := true
+		for _, row := range rows {
+			found := false
+			for _, managedRow := range managedRows {
+				if connectionIds[row.key()] == managedRow.ConnectionId && row.matches(managedRow, false) {
+					found = true
+					break
+				}
+			}
+			unchanged = unchanged && found
+		}
+		if unchanged {
+			return
+		}
+	}
+	data.Content = basetypes.NewStringValue(renderConnectionsCsv(managedRows, false))
+}
+
+// createConnectionsCsvRows creates the Connections of the rows and returns their IDs keyed by the local Node and port
+// of the Connection.
+func createConnectionsCsvRows(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId string, rows []ConnectionsCsvRow) map[string]string {
+	connectionIds := map[string]string{}
+	if len(rows) == 0 {
+		return connectionIds
+	}
+
+	nodeIds := getConnectionsCsvNodeIds(getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes", fabricId), "nodes"))
+	if diags.HasError() {
+		return connectionIds
+	}
+
+	// The Node can be provided by name, by ID or as the id of a hyperfabric_node resource
+	getNodeId := func(row ConnectionsCsvRow, node string) string {
+		node = getConnectionsCsvNodeReference(node)
+		if nodeIds[node] == "" {
+			diags.AddAttributeError(
+				path.Root("content"),
+				"Node Not Found",
+				fmt.Sprintf("Line %d: Node %q was not found in Fabric %q.", row.Line, node, fabricId),
+			)
+		}
+		return nodeIds[node]
+	}
+
+	payloadList := []map[string]interface{}{}
+	for index, row := range rows {
+		rows[index].LocalNodeId = getNodeId(row, row.LocalNode)
+		rows[index].RemoteNodeId = getNodeId(row, row.RemoteNode)
+		if diags.HasError() {
+			return connectionIds
+		}
+		payloadMap := map[string]interface{}{
+			"local":  map[string]string{"nodeId": rows[index].LocalNodeId, "portName": row.LocalPort},
+			"remote": map[string]string{"nodeId": rows[index].RemoteNodeId, "portName": row.RemotePort},
+		}
+		if row.Pluggable != "" {
+			payloadMap["pluggable"] = row.Pluggable
+		}
+		if row.Description != "" {
+			payloadMap["description"] = row.Description
+		}
+		payloadList = append(payloadList, payloadMap)
+	}
+
+	marshalPayload, err := json.Marshal(map[string]interface{}{"connections": payloadList})
+	if err != nil {
+		diags.AddError(
+			"Marshalling of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return connectionIds
+	}
+
+	jsonPayload, err := gabs.ParseJSON(marshalPayload)
+	if err != nil {
+		diags.AddError(
+			"Construction of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return connectionIds
+	}
+
+	container := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/connections", fabricId), "POST", jsonPayload)
+	if diags.HasError() || container == nil {
+		return connectionIds
+	}
+
+	for _, connectionContainer := range container.Search("connections").Children() {
+		connection, ok := connectionContainer.Data().(map[string]interface{})
+		if !ok {
+			continue
+		}
+		local, _ := connection["local"].(map[string]interface{})
+		for _, row := range rows {
+			if row.LocalNodeId == getStringFromMap(local, "nodeId") && row.LocalPort == getStringFromMap(local, "portName") {
+				connectionIds[row.key()] = getStringFromMap(connection, "id")
+			}
+		}
+	}
+	return connectionIds
+}
+
+// getConnectionsCsvNodeIds returns the IDs of the Nodes of a Fabric keyed by both their name and their ID.
+func getConnectionsCsvNodeIds(nodes []map[string]interface{}) map[string]string {
+	nodeIds := map[string]string{}
+	for _, node := range nodes {
+		nodeId := getStringFromMap(node, "nodeId")
+		if nodeId == "" {
+			continue
+		}
+		nodeIds[getStringFromMap(node, "name")] = nodeId
+		nodeIds[nodeId] = nodeId
+	}
+	return nodeIds
+}
+
+func checkAndSetConnectionsCsvIds(data *ConnectionsCsvResourceModel) {
+	// The resource can be imported with the ID of the Fabric
+	if !strings.HasSuffix(data.Id.ValueString(), "/connectionsCsv") {
+		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/connectionsCsv", data.Id.ValueString()))
+	}
+	if data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" {
+		data.FabricId = basetypes.NewStringValue(strings.TrimSuffix(data.Id.ValueString(), "/connectionsCsv"))
+	}
+}
@@ -0,0 +1,201 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConnectionsCsvResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: ConnectionsCsv - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testConnectionsCsvResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_connections_csv.test", "connection_ids.%", "1"),
+					resource.TestCheckResourceAttrSet("hyperfabric_connections_csv.test", "connection_ids.node1:Ethernet1_1"),
+				),
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: ConnectionsCsv - Update with all config and verify provided values.")
+				},
+				Config:             testConnectionsCsvResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_connections_csv.test", "connection_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("hyperfabric_connections_csv.test", "connection_ids.node1:Ethernet1_1"),
+					resource.TestCheckResourceAttrSet("hyperfabric_connections_csv.test", "connection_ids.node1:Ethernet1_2"),
+					resource.TestCheckResourceAttr("data.hyperfabric_connections_csv.test", "content", "cable_label,local_node,local_port,remote_node,remote_port,pluggable,description\nnode1:Ethernet1_1 <> node2:Ethernet1_1,node1,Ethernet1_1,node2,Ethernet1_1,QDD-400-AOC7M,First connection\nnode1:Ethernet1_2 <> node2:Ethernet1_2,node1,Ethernet1_2,node2,Ethernet1_2,,Second connection\n"),
+				),
+			},
+			// Run Plan Only with all config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: ConnectionsCsv - Run Plan Only with all config and check that plan is empty.")
+				},
+				Config:             testConnectionsCsvResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// ImportState testing with the ID of the Fabric.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: ConnectionsCsv - ImportState testing with the ID of the Fabric.")
+				},
+				ResourceName:                         "hyperfabric_connections_csv.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "fabric_id",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["hyperfabric_connections_csv.test"].Primary.Attributes["fabric_id"], nil
+				},
+			},
+			// Update with a Node which does not exist and verify the error.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: ConnectionsCsv - Update with a Node which does not exist and verify the error.")
+				},
+				Config:      testConnectionsCsvResourceHclConfig(fabricName, "unknown_node"),
+				ExpectError: regexp.MustCompile("Node Not Found"),
+			},
+		},
+	})
+}
+
+func testConnectionsCsvResourceHclConfig(fabricName string, configType string) string {
+	content := `local_node,local_port,remote_node,remote_port
+    node1,Ethernet1_1,node2,Ethernet1_1`
+	if configType == "full" {
+		content = `local_node,local_port,remote_node,remote_port,pluggable,description
+    node1,Ethernet1_1,node2,Ethernet1_1,QDD-400-AOC7M,First connection
+    node1,Ethernet1_2,node2,Ethernet1_2,,Second connection`
+	} else if configType == "unknown_node" {
+		content = `local_node,local_port,remote_node,remote_port
+    node1,Ethernet1_1,node3,Ethernet1_1`
+	}
+	return fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+    name = "%[1]s"
+}
+resource "hyperfabric_node" "node1" {
+    fabric_id = hyperfabric_fabric.test.id
+    name = "node1"
+    model_name = "HF6100-32D"
+    roles = ["LEAF"]
+}
+resource "hyperfabric_node" "node2" {
+    fabric_id = hyperfabric_fabric.test.id
+    name = "node2"
+    model_name = "HF6100-32D"
+    roles = ["LEAF"]
+}
+resource "hyperfabric_connections_csv" "test" {
+    fabric_id = hyperfabric_fabric.test.id
+    content = <<-EOT
+    %[2]s
+    EOT
+    depends_on = [hyperfabric_node.node1, hyperfabric_node.node2]
+}
+data "hyperfabric_connections_csv" "test" {
+    fabric_id = hyperfabric_fabric.test.id
+    depends_on = [hyperfabric_connections_csv.test]
+}
+`, fabricName, content)
+}
+
+func TestConnectionsCsvNodeIds(t *testing.T) {
+	// Node objects as returned by the /fabrics/{fabricId}/nodes endpoint
+	nodeIds := getConnectionsCsvNodeIds([]map[string]interface{}{
+		{"nodeId": "0a1b2c3d-0000-4000-8000-000000000001", "name": "leaf1", "roles": []interface{}{"LEAF"}},
+		{"nodeId": "0a1b2c3d-0000-4000-8000-000000000002", "name": "spine1", "roles": []interface{}{"SPINE"}},
+		{"name": "incomplete"},
+	})
+
+	for reference, expected := range map[string]string{
+		"leaf1":                                "0a1b2c3d-0000-4000-8000-000000000001",
+		"spine1":                               "0a1b2c3d-0000-4000-8000-000000000002",
+		"0a1b2c3d-0000-4000-8000-000000000002": "0a1b2c3d-0000-4000-8000-000000000002",
+		"incomplete":                           "",
+		"leaf2":                                "",
+	} {
+		if nodeIds[reference] != expected {
+			t.Errorf("expected Node %q to resolve to %q, got: %q", reference, expected, nodeIds[reference])
+		}
+	}
+}
+
+func TestConnectionsCsvRowMatches(t *testing.T) {
+	rows, err := parseConnectionsCsv("\ufefflocal_node,local_port,remote_node,remote_port\nfab1/nodes/0a1b2c3d-0000-4000-8000-000000000001,Ethernet1_1,spine1,Ethernet1_1\n")
+	if err != nil {
+		t.Fatalf("expected CSV with a byte order mark to be valid, got: %s", err)
+	}
+	// Row as rendered from a Connection returned by the API
+	connection := ConnectionsCsvRow{
+		LocalNode:    "leaf1",
+		LocalNodeId:  "0a1b2c3d-0000-4000-8000-000000000001",
+		LocalPort:    "Ethernet1_1",
+		RemoteNode:   "spine1",
+		RemoteNodeId: "0a1b2c3d-0000-4000-8000-000000000002",
+		RemotePort:   "Ethernet1_1",
+	}
+	if !rows[0].matches(connection, false) {
+		t.Errorf("expected a Node referenced by the id of a hyperfabric_node resource to match the Connection")
+	}
+
+	// Pluggable selected by the API when the pluggable is not configured
+	connection.Pluggable = "QDD-400-AOC7M"
+	if !rows[0].matches(connection, false) {
+		t.Errorf("expected a row without pluggable to match the Connection with the default pluggable")
+	}
+	if rows[0].matches(connection, true) {
+		t.Errorf("expected a row without pluggable not to strictly match the Connection with a pluggable")
+	}
+	rows[0].Pluggable = "QDD-400-AOC3M"
+	if rows[0].matches(connection, false) {
+		t.Errorf("expected a row with a different pluggable not to match the Connection")
+	}
+	connection.Pluggable = ""
+	if rows[0].matches(connection, false) {
+		t.Errorf("expected a row with a pluggable not to match the Connection without pluggable")
+	}
+}
+
+func TestRenderConnectionsCsv(t *testing.T) {
+	// Rows in the order returned by the API
+	rows := []ConnectionsCsvRow{
+		{LocalNode: "leaf2", LocalPort: "Ethernet1_1", RemoteNode: "spine1", RemotePort: "Ethernet1_2"},
+		{LocalNode: "leaf1", LocalPort: "Ethernet1_2", RemoteNode: "spine2", RemotePort: "Ethernet1_1", Pluggable: "QDD-400-AOC7M"},
+		{LocalNode: "leaf1", LocalPort: "Ethernet1_1", RemoteNode: "spine1", RemotePort: "Ethernet1_1", Description: "First connection"},
+	}
+
+	expected := "local_node,local_port,remote_node,remote_port,pluggable,description\n" +
+		"leaf1,Ethernet1_1,spine1,Ethernet1_1,,First connection\n" +
+		"leaf1,Ethernet1_2,spine2,Ethernet1_1,QDD-400-AOC7M,\n" +
+		"leaf2,Ethernet1_1,spine1,Ethernet1_2,,\n"
+	if content := renderConnectionsCsv(rows, false); content != expected {
+		t.Errorf("expected the rows to be rendered sorted by local Node and port, got:\n%s", content)
+	}
+	if rows[0].LocalNode != "leaf2" {
+		t.Errorf("expected the order of the provided rows to be preserved, got: %s first", rows[0].LocalNode)
+	}
+}
@@ -0,0 +1,255 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConnectionsDataSource{}
+
+func NewConnectionsDataSource() datasource.DataSource {
+	return &ConnectionsDataSource{}
+}
+
+// ConnectionsDataSource defines the data source implementation.
+type ConnectionsDataSource struct {
+	client *client.Client
+}
+
+// ConnectionsDataSourceModel describes the data source data model.
+type ConnectionsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	NodeId      types.String `tfsdk:"node_id"`
+	Connections types.List   `tfsdk:"connections"`
+}
+
+// ConnectionSummaryDataSourceModel describes a Connection in the list of Connections.
+type ConnectionSummaryDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	ConnectionId types.String `tfsdk:"connection_id"`
+	FabricId     types.String `tfsdk:"fabric_id"`
+	Description  types.String `tfsdk:"description"`
+	Pluggable    types.String `tfsdk:"pluggable"`
+	Local        types.Object `tfsdk:"local"`
+	Remote       types.Object `tfsdk:"remote"`
+	OsType       types.String `tfsdk:"os_type"`
+	Unrecognized types.Bool   `tfsdk:"unrecognized"`
+}
+
+func ConnectionSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":            types.StringType,
+			"connection_id": types.StringType,
+			"fabric_id":     types.StringType,
+			"description":   types.StringType,
+			"pluggable":     types.StringType,
+			"local":         types.ObjectType{AttrTypes: LocalRemoteConnectionResourceModelAttributeType()},
+			"remote":        types.ObjectType{AttrTypes: LocalRemoteConnectionResourceModelAttributeType()},
+			"os_type":       types.StringType,
+			"unrecognized":  types.BoolType,
+		},
+	}
+}
+
+func (d *ConnectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_connections")
+	resp.TypeName = req.ProviderTypeName + "_connections"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_connections")
+}
+
+func (d *ConnectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_connections")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Connections data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/connections`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "The ID or the name of a Node to only return the Connections with the Node on the local or the remote side.",
+				Optional:            true,
+			},
+			"connections": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Connections matching the filters sorted by local Node name and port name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Connection in a Fabric.",
+							Computed:            true,
+						},
+						"connection_id": schema.StringAttribute{
+							MarkdownDescription: "`connection_id` defines the unique identifier of a Connection.",
+							Computed:            true,
+						},
+						"fabric_id": schema.StringAttribute{
+							MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the Connection.",
+							Computed:            true,
+						},
+						"pluggable": schema.StringAttribute{
+							MarkdownDescription: "The type of pluggable used for the Connection.",
+							Computed:            true,
+						},
+						"local":  getLocalRemoteConnectionDataSourceSchemaAttribute("local"),
+						"remote": getLocalRemoteConnectionDataSourceSchemaAttribute("remote"),
+						"os_type": schema.StringAttribute{
+							MarkdownDescription: "The operating system type of the remote side of the Connection.",
+							Computed:            true,
+						},
+						"unrecognized": schema.BoolAttribute{
+							MarkdownDescription: "If the remote side of the Connection is recognized or not.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_connections")
+}
+
+func getLocalRemoteConnectionDataSourceSchemaAttribute(side string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("An object that represents the %s side of the Connection.", side),
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The ID of the Node used as %s side of this Connection.", side),
+				Computed:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The name of the Node used as %s side of this Connection.", side),
+				Computed:            true,
+			},
+			"port_name": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The name of the port on the Node used as %s side of this Connection.", side),
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConnectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_connections")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_connections")
+}
+
+func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_connections")
+	var data *ConnectionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/connections", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_connections with id '%s'", data.Id.ValueString()))
+
+	connections := getConnectionsList(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString(), data.NodeId.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Connections, _ = types.ListValueFrom(ctx, ConnectionSummaryDataSourceModelAttributeType(), connections)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_connections with id '%s'", data.Id.ValueString()))
+}
+
+// getConnectionsList returns the Connections of the Fabric sorted by local Node name and port name. When nodeId is
+// not empty, only the Connections with a matching Node ID or name on the local or the remote side are returned.
+func getConnectionsList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId, nodeId string) []ConnectionSummaryDataSourceModel {
+	// The Node ID can be provided as the id of a hyperfabric_node resource
+	if _, splitId := splitNodeId(nodeId); splitId != "" {
+		nodeId = splitId
+	}
+
+	objects := make([]map[string]interface{}, 0)
+	for _, connection := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/connections", fabricId), "connections") {
+		local, _ := connection["local"].(map[string]interface{})
+		remote, _ := connection["remote"].(map[string]interface{})
+		if nodeId == "" || nodeReferenceMatches(local, nodeId) || nodeReferenceMatches(remote, nodeId) {
+			objects = append(objects, connection)
+		}
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		localI, _ := objects[i]["local"].(map[string]interface{})
+		localJ, _ := objects[j]["local"].(map[string]interface{})
+		if getStringFromMap(localI, "nodeName") != getStringFromMap(localJ, "nodeName") {
+			return getStringFromMap(localI, "nodeName") < getStringFromMap(localJ, "nodeName")
+		}
+		return getStringFromMap(localI, "portName") < getStringFromMap(localJ, "portName")
+	})
+
+	connections := make([]ConnectionSummaryDataSourceModel, 0)
+	for _, connection := range objects {
+		connectionFabricId := getStringFromMap(connection, "fabricId")
+		if connectionFabricId == "" {
+			connectionFabricId = fabricId
+		}
+		local, _ := connection["local"].(map[string]interface{})
+		remote, _ := connection["remote"].(map[string]interface{})
+		connections = append(connections, ConnectionSummaryDataSourceModel{
+			Id:           basetypes.NewStringValue(fmt.Sprintf("%s/connections/%s", connectionFabricId, getStringFromMap(connection, "id"))),
+			ConnectionId: basetypes.NewStringValue(getStringFromMap(connection, "id")),
+			FabricId:     basetypes.NewStringValue(connectionFabricId),
+			Description:  basetypes.NewStringValue(getStringFromMap(connection, "description")),
+			Pluggable:    basetypes.NewStringValue(getStringFromMap(connection, "pluggable")),
+			Local:        NewLocalRemoteConnectionObject(ctx, local),
+			Remote:       NewLocalRemoteConnectionObject(ctx, remote),
+			OsType:       basetypes.NewStringValue(getStringFromMap(connection, "osType")),
+			Unrecognized: basetypes.NewBoolValue(connection["unrecognized"] == true),
+		})
+	}
+	return connections
+}
@@ -0,0 +1,396 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Set by the provider configuration, the consistency checks query the Fabric during plan to detect
+// conflicts with objects that already exist in the Fabric.
+var globalConsistencyChecks bool = true
+
+// getFabricObjectsList returns the list of objects found under listKey in the response of a GET request to path.
+// Failures are only logged as the consistency checks should never prevent a plan when the Fabric cannot be queried.
+func getFabricObjectsList(ctx context.Context, client *client.Client, path, listKey string) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0)
+	if client == nil {
+		return objects
+	}
+
+	var requestDiags diag.Diagnostics
+	requestData := DoRestRequest(ctx, &requestDiags, client, path, "GET", nil)
+	if requestDiags.HasError() || requestData == nil || requestData.Data() == nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to retrieve '%s' for consistency checks", path))
+		return objects
+	}
+
+	if responseMap, ok := requestData.Data().(map[string]interface{}); ok {
+		if list, ok := responseMap[listKey].([]interface{}); ok {
+			for _, object := range list {
+				if objectMap, ok := object.(map[string]interface{}); ok {
+					objects = append(objects, objectMap)
+				}
+			}
+		}
+	}
+	return objects
+}
+
+func getStringFromMap(data map[string]interface{}, key string) string {
+	if value, ok := data[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func getInt64FromMap(data map[string]interface{}, key string) int64 {
+	if value, ok := data[key].(float64); ok {
+		return int64(value)
+	}
+	return 0
+}
+
+func getStringsFromMap(data map[string]interface{}, key string) []string {
+	values := make([]string, 0)
+	if list, ok := data[key].([]interface{}); ok {
+		for _, value := range list {
+			if stringValue, ok := value.(string); ok {
+				values = append(values, stringValue)
+			}
+		}
+	}
+	return values
+}
+
+// splitNodeId splits an id in the {fabricId}/nodes/{nodeId} format into the Fabric and Node ids.
+func splitNodeId(nodeId string) (string, string) {
+	if !strings.Contains(nodeId, "/nodes/") {
+		return "", ""
+	}
+	splitId := strings.SplitN(nodeId, "/nodes/", 2)
+	return splitId[0], strings.Split(splitId[1], "/")[0]
+}
+
+// matchesMemberValue returns true when both values are equal or one of them is the "*" wildcard.
+func matchesMemberValue(value, otherValue string) bool {
+	return value == otherValue || value == "*" || otherValue == "*"
+}
+
+// isUntaggedMember returns true when the member is untagged, either explicitly or because it has no VLAN ID.
+func isUntaggedMember(member MemberResourceModel) bool {
+	if !member.Untagged.IsNull() && !member.Untagged.IsUnknown() {
+		return member.Untagged.ValueBool()
+	}
+	return member.VlanId.IsNull()
+}
+
+// checkVniMembersConsistency adds an attribute error for each planned VNI member assigned to a port or port channel that
+// cannot be a VNI member because of its roles, that uses a VLAN ID already assigned to the same port by another VNI, or that is
+// untagged on a port which is already an untagged member of another VNI. Only the VNIs that already exist are compared, so
+// two VNIs planned with an untagged member on the same port are only rejected by the API during apply.
+func checkVniMembersConsistency(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId, vniId string, members []MemberResourceModel) {
+	if !globalConsistencyChecks || client == nil || fabricId == "" || len(members) == 0 {
+		return
+	}
+
+	nodePorts := map[string][]map[string]interface{}{}
+	var portChannels []map[string]interface{}
+	for _, member := range members {
+		if member.NodeId.IsUnknown() || member.PortName.IsUnknown() {
+			continue
+		}
+		nodeId, portName := member.NodeId.ValueString(), member.PortName.ValueString()
+		if nodeId == "" || nodeId == "*" || portName == "" || portName == "*" {
+			continue
+		}
+
+		if isValidPortChannelName(portName) {
+			if portChannels == nil {
+				portChannels = getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels", fabricId), "portChannels")
+			}
+			for _, portChannel := range portChannels {
+				if getStringFromMap(portChannel, "name") != portName || !ContainsString(getStringsFromMap(portChannel, "roles"), "ROUTED_PORT") {
+					continue
+				}
+				diags.AddAttributeError(
+					path.Root("members"),
+					"Invalid VNI Member",
+					fmt.Sprintf("The port channel '%s' has the 'ROUTED_PORT' role and cannot be a member of a VNI. Configure the port channel with the 'HOST_PORT' role before adding it to the VNI.", portName),
+				)
+			}
+			continue
+		}
+
+		if _, ok := nodePorts[nodeId]; !ok {
+			nodePorts[nodeId] = getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s/ports", fabricId, nodeId), "ports")
+		}
+
+		for _, port := range nodePorts[nodeId] {
+			if getStringFromMap(port, "name") != portName {
+				continue
+			}
+			for _, role := range getStringsFromMap(port, "roles") {
+				if role == "ROUTED_PORT" || role == "FABRIC_PORT" {
+					diags.AddAttributeError(
+						path.Root("members"),
+						"Invalid VNI Member",
+						fmt.Sprintf("The port '%s' of node '%s' has the '%s' role and cannot be a member of a VNI. Configure the port with the 'HOST_PORT' role before adding it to the VNI.", portName, nodeId, role),
+					)
+				}
+			}
+		}
+	}
+
+	for _, vni := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/vnis", fabricId), "vnis") {
+		if vniId != "" && getStringFromMap(vni, "id") == vniId {
+			continue
+		}
+		existingMembers, _ := vni["members"].([]interface{})
+		for _, existingMember := range existingMembers {
+			existingMemberMap, ok := existingMember.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			existingPort, _ := existingMemberMap["port"].(map[string]interface{})
+			if existingUntagged, _ := existingMemberMap["untagged"].(bool); existingUntagged {
+				for _, member := range members {
+					if !isUntaggedMember(member) || member.NodeId.IsUnknown() || member.PortName.IsUnknown() {
+						continue
+					}
+					if matchesMemberValue(member.NodeId.ValueString(), getStringFromMap(existingPort, "nodeId")) &&
+						matchesMemberValue(member.PortName.ValueString(), getStringFromMap(existingPort, "portName")) {
+						diags.AddAttributeError(
+							path.Root("members"),
+							"Duplicate Untagged VNI",
+							fmt.Sprintf("The port '%s' of node '%s' is already an untagged member of the VNI '%s'. A port can only be an untagged member of a single VNI.", member.PortName.ValueString(), member.NodeId.ValueString(), getStringFromMap(vni, "name")),
+						)
+					}
+				}
+				continue
+			}
+			existingVlanId, ok := existingMemberMap["vlanId"].(float64)
+			if !ok {
+				continue
+			}
+			for _, member := range members {
+				if member.VlanId.IsNull() || member.VlanId.IsUnknown() || member.NodeId.IsUnknown() || member.PortName.IsUnknown() {
+					continue
+				}
+				if member.VlanId.ValueInt64() == int64(existingVlanId) &&
+					matchesMemberValue(member.NodeId.ValueString(), getStringFromMap(existingPort, "nodeId")) &&
+					matchesMemberValue(member.PortName.ValueString(), getStringFromMap(existingPort, "portName")) {
+					diags.AddAttributeError(
+						path.Root("members"),
+						"Duplicate VLAN ID",
+						fmt.Sprintf("The VLAN ID '%d' is already used on port '%s' of node '%s' by the VNI '%s'.", member.VlanId.ValueInt64(), member.PortName.ValueString(), member.NodeId.ValueString(), getStringFromMap(vni, "name")),
+					)
+				}
+			}
+		}
+	}
+}
+
+// checkPortChannelMembersConsistency adds an attribute error for each planned Port Channel member assigned to a port
+// that cannot be a Port Channel member because of its roles, or that is already a member of another Port Channel.
+func checkPortChannelMembersConsistency(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId, portChannelId string, members []PortChannelMemberResourceModel) {
+	if !globalConsistencyChecks || client == nil || fabricId == "" || len(members) == 0 {
+		return
+	}
+
+	nodePorts := map[string][]map[string]interface{}{}
+	for _, member := range members {
+		if member.NodeId.IsUnknown() || member.PortName.IsUnknown() {
+			continue
+		}
+		nodeId, portName := member.NodeId.ValueString(), member.PortName.ValueString()
+
+		if _, ok := nodePorts[nodeId]; !ok {
+			nodePorts[nodeId] = getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s/ports", fabricId, nodeId), "ports")
+		}
+
+		for _, port := range nodePorts[nodeId] {
+			if getStringFromMap(port, "name") != portName {
+				continue
+			}
+			for _, role := range getStringsFromMap(port, "roles") {
+				if role == "ROUTED_PORT" || role == "FABRIC_PORT" {
+					diags.AddAttributeError(
+						path.Root("members"),
+						"Invalid Port Channel Member",
+						fmt.Sprintf("The port '%s' of node '%s' has the '%s' role and cannot be a member of a port channel. Configure the port with the 'LAG_PORT' role before adding it to the port channel.", portName, nodeId, role),
+					)
+				}
+			}
+		}
+	}
+
+	for _, portChannel := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels", fabricId), "portChannels") {
+		if portChannelId != "" && getStringFromMap(portChannel, "id") == portChannelId {
+			continue
+		}
+		existingMembers, _ := portChannel["members"].([]interface{})
+		for _, existingMember := range existingMembers {
+			existingMemberMap, ok := existingMember.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, member := range members {
+				if member.NodeId.ValueString() == getStringFromMap(existingMemberMap, "nodeId") && member.PortName.ValueString() == getStringFromMap(existingMemberMap, "portName") {
+					diags.AddAttributeError(
+						path.Root("members"),
+						"Duplicate Port Channel Member",
+						fmt.Sprintf("The port '%s' of node '%s' is already a member of the port channel '%s'.", member.PortName.ValueString(), member.NodeId.ValueString(), getStringFromMap(portChannel, "name")),
+					)
+				}
+			}
+		}
+	}
+}
+
+// checkSubInterfaceParentConsistency adds an attribute error when the parent port of a Sub-Interface has been broken out.
+func checkSubInterfaceParentConsistency(ctx context.Context, diags *diag.Diagnostics, client *client.Client, nodeId, name string) {
+	if !globalConsistencyChecks || client == nil || nodeId == "" || !strings.Contains(name, ".") {
+		return
+	}
+
+	parentName := name[:strings.LastIndex(name, ".")]
+	for _, breakout := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/breakouts", nodeId), "breakouts") {
+		if ContainsString(getStringsFromMap(breakout, "ports"), parentName) {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Invalid Sub-Interface Parent",
+				fmt.Sprintf("The port '%s' is broken out by the Breakout '%s' and cannot be the parent of a Sub-Interface. Use one of its Breakout Ports (i.e. '%s_1') instead.", parentName, getStringFromMap(breakout, "name"), parentName),
+			)
+		}
+	}
+}
+
+// fabricLoopbacksCacheKey identifies the Loopbacks of a Fabric retrieved with a client.
+type fabricLoopbacksCacheKey struct {
+	client   *client.Client
+	fabricId string
+}
+
+// fabricLoopbacksCache holds the Loopbacks of the Fabrics already retrieved for the consistency checks, so the Nodes
+// of a Fabric are only queried once per Terraform operation instead of once per planned Loopback.
+var fabricLoopbacksCache sync.Map
+
+// getFabricLoopbacksList returns the Loopbacks of all the Nodes of the Fabric. The name of the Node of each Loopback
+// is added to the Loopback as nodeName. The API only lists Loopbacks per Node, so the result is cached.
+func getFabricLoopbacksList(ctx context.Context, client *client.Client, fabricId string) []map[string]interface{} {
+	cacheKey := fabricLoopbacksCacheKey{client: client, fabricId: fabricId}
+	if loopbacks, ok := fabricLoopbacksCache.Load(cacheKey); ok {
+		return loopbacks.([]map[string]interface{})
+	}
+
+	loopbacks := make([]map[string]interface{}, 0)
+	for _, node := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes", fabricId), "nodes") {
+		nodeId := getStringFromMap(node, "nodeId")
+		if nodeId == "" {
+			continue
+		}
+		for _, loopback := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s/loopbacks", fabricId, nodeId), "loopbacks") {
+			loopback["nodeName"] = getStringFromMap(node, "name")
+			loopbacks = append(loopbacks, loopback)
+		}
+	}
+	actual, _ := fabricLoopbacksCache.LoadOrStore(cacheKey, loopbacks)
+	return actual.([]map[string]interface{})
+}
+
+// clearFabricLoopbacksCache removes the cached Loopbacks of the Fabric of the Node after a Loopback has been changed.
+func clearFabricLoopbacksCache(client *client.Client, nodeId string) {
+	fabricId, _ := splitNodeId(nodeId)
+	fabricLoopbacksCache.Delete(fabricLoopbacksCacheKey{client: client, fabricId: fabricId})
+}
+
+// checkLoopbackAddressConsistency adds an attribute error when a planned Loopback address is already configured on
+// another Loopback in the same VRF of the Fabric.
+func checkLoopbackAddressConsistency(ctx context.Context, diags *diag.Diagnostics, client *client.Client, nodeId, loopbackId, vrfId string, addresses map[string]string) {
+	if !globalConsistencyChecks || client == nil || len(addresses) == 0 {
+		return
+	}
+
+	fabricId, _ := splitNodeId(nodeId)
+	if fabricId == "" {
+		return
+	}
+
+	for _, loopback := range getFabricLoopbacksList(ctx, client, fabricId) {
+		if loopbackId != "" && getStringFromMap(loopback, "id") == loopbackId {
+			continue
+		}
+		otherVrfId := getStringFromMap(loopback, "vrfId")
+		if vrfId != "" && otherVrfId != "" && vrfId != otherVrfId {
+			continue
+		}
+		for attributeName, address := range addresses {
+			for _, otherAddress := range []string{getStringFromMap(loopback, "ipv4Address"), getStringFromMap(loopback, "ipv6Address")} {
+				if address != "" && address == otherAddress {
+					diags.AddAttributeError(
+						path.Root(attributeName),
+						"Duplicate Loopback Address",
+						fmt.Sprintf("The address '%s' is already configured on the Loopback '%s' of node '%s'.", address, getStringFromMap(loopback, "name"), getStringFromMap(loopback, "nodeName")),
+					)
+				}
+			}
+		}
+	}
+}
+
+// checkBgpNeighborConsistency adds an attribute error when the peer address of a planned BGP Neighbor is already used by another
+// BGP Neighbor in the same VRF of the Node, or when its update source is configured in another VRF than the BGP Neighbor.
+func checkBgpNeighborConsistency(ctx context.Context, diags *diag.Diagnostics, client *client.Client, nodeId, bgpNeighborId, vrfId, peerAddress, updateSource string) {
+	if !globalConsistencyChecks || client == nil || nodeId == "" {
+		return
+	}
+
+	for _, bgpNeighbor := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors", nodeId), "bgpNeighbors") {
+		if bgpNeighborId != "" && getStringFromMap(bgpNeighbor, "id") == bgpNeighborId {
+			continue
+		}
+		otherVrfId := getStringFromMap(bgpNeighbor, "vrfId")
+		if vrfId != "" && otherVrfId != "" && vrfId != otherVrfId {
+			continue
+		}
+		if peerAddress != "" && getStringFromMap(bgpNeighbor, "peerAddress") == peerAddress {
+			diags.AddAttributeError(
+				path.Root("peer_address"),
+				"Duplicate BGP Neighbor",
+				fmt.Sprintf("The peer address '%s' is already configured on the BGP Neighbor '%s' of the Node.", peerAddress, getStringFromMap(bgpNeighbor, "name")),
+			)
+		}
+	}
+
+	// The update source may be created in the same plan, so only a source found in another VRF is reported
+	if updateSource == "" || vrfId == "" {
+		return
+	}
+	sourcesPath, listKey := fmt.Sprintf("/api/v1/fabrics/%s/loopbacks", nodeId), "loopbacks"
+	if strings.Contains(updateSource, ".") {
+		sourcesPath, listKey = fmt.Sprintf("/api/v1/fabrics/%s/subInterfaces", nodeId), "subInterfaces"
+	}
+	for _, source := range getFabricObjectsList(ctx, client, sourcesPath, listKey) {
+		sourceVrfId := getStringFromMap(source, "vrfId")
+		if getStringFromMap(source, "name") == updateSource && sourceVrfId != "" && sourceVrfId != vrfId {
+			diags.AddAttributeError(
+				path.Root("update_source"),
+				"Invalid BGP Update Source",
+				fmt.Sprintf("The update source '%s' is configured in another VRF than the BGP Neighbor.", updateSource),
+			)
+		}
+	}
+}
@@ -172,9 +172,20 @@ func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func getAndSetDeviceAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *DeviceDataSourceModel) {
+	for _, newDevice := range getDevicesList(ctx, diags, client) {
+		if (!data.SerialNumber.IsNull() && !data.SerialNumber.IsUnknown() && data.SerialNumber.ValueString() != "" && newDevice.SerialNumber == data.SerialNumber) ||
+			(!data.DeviceId.IsNull() && !data.DeviceId.IsUnknown() && data.DeviceId.ValueString() != "" && newDevice.DeviceId == data.DeviceId) {
+			*data = newDevice
+		}
+	}
+}
+
+// getDevicesList returns all the Devices of the Organization.
+func getDevicesList(ctx context.Context, diags *diag.Diagnostics, client *client.Client) []DeviceDataSourceModel {
+	devicesList := make([]DeviceDataSourceModel, 0)
 	requestData := DoRestRequest(ctx, diags, client, "/api/v1/devices", "GET", nil)
 	if diags.HasError() {
-		return
+		return devicesList
 	}
 
 	if requestData.Data() != nil {
@@ -204,12 +215,10 @@ func getAndSetDeviceAttributes(ctx context.Context, diags *diag.Diagnostics, cli
 							newDevice.SerialNumber = basetypes.NewStringValue(attributeValue.(string))
 						}
 					}
-					if (!data.SerialNumber.IsNull() && !data.SerialNumber.IsUnknown() && data.SerialNumber.ValueString() != "" && newDevice.SerialNumber == data.SerialNumber) ||
-						(!data.DeviceId.IsNull() && !data.DeviceId.IsUnknown() && data.DeviceId.ValueString() != "" && newDevice.DeviceId == data.DeviceId) {
-						*data = newDevice
-					}
+					devicesList = append(devicesList, newDevice)
 				}
 			}
 		}
 	}
+	return devicesList
 }
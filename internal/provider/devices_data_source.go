@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DevicesDataSource{}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &DevicesDataSource{}
+}
+
+// DevicesDataSource defines the data source implementation.
+type DevicesDataSource struct {
+	client *client.Client
+}
+
+// DevicesDataSourceModel describes the data source data model.
+type DevicesDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Unbound   types.Bool   `tfsdk:"unbound"`
+	ModelName types.String `tfsdk:"model_name"`
+	OsType    types.String `tfsdk:"os_type"`
+	FabricId  types.String `tfsdk:"fabric_id"`
+	RackId    types.String `tfsdk:"rack_id"`
+	Devices   types.List   `tfsdk:"devices"`
+}
+
+func DeviceDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":            types.StringType,
+			"device_id":     types.StringType,
+			"model_name":    types.StringType,
+			"fabric_id":     types.StringType,
+			"node_id":       types.StringType,
+			"os_type":       types.StringType,
+			"rack_id":       types.StringType,
+			"roles":         types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"serial_number": types.StringType,
+		},
+	}
+}
+
+func (d *DevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_devices")
+	resp.TypeName = req.ProviderTypeName + "_devices"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_devices")
+}
+
+func (d *DevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_devices")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Devices data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to `devices`.",
+				Computed:            true,
+			},
+			"unbound": schema.BoolAttribute{
+				MarkdownDescription: "The binding state to only return the Devices not bound to a Node when true or the Devices bound to a Node when false.",
+				Optional:            true,
+			},
+			"model_name": schema.StringAttribute{
+				MarkdownDescription: "The model name to only return the Devices of this model.",
+				Optional:            true,
+			},
+			"os_type": schema.StringAttribute{
+				MarkdownDescription: "The operating system type to only return the Devices running this operating system.",
+				Optional:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` to only return the Devices bound to a Node in this Fabric.",
+				Optional:            true,
+			},
+			"rack_id": schema.StringAttribute{
+				MarkdownDescription: "`rack_id` to only return the Devices located in this Rack.",
+				Optional:            true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Devices matching the filters sorted by serial number.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Device.",
+							Computed:            true,
+						},
+						"device_id": schema.StringAttribute{
+							MarkdownDescription: "`device_id` defines the unique identifier of a Device.",
+							Computed:            true,
+						},
+						"model_name": schema.StringAttribute{
+							MarkdownDescription: "The model name of the Device.",
+							Computed:            true,
+						},
+						"fabric_id": schema.StringAttribute{
+							MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "`node_id` defines the unique identifier of a Node.",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "The serial number of the Device.",
+							Computed:            true,
+						},
+						"os_type": schema.StringAttribute{
+							MarkdownDescription: "The operating system type of the Device.",
+							Computed:            true,
+						},
+						"rack_id": schema.StringAttribute{
+							MarkdownDescription: "`rack_id` defines the unique identifier of a Rack.",
+							Computed:            true,
+						},
+						"roles": getDeviceRolesSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_devices")
+}
+
+func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_devices")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_devices")
+}
+
+func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_devices")
+	var data *DevicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue("devices")
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_devices with id '%s'", data.Id.ValueString()))
+
+	devices := make([]DeviceDataSourceModel, 0)
+	for _, device := range getDevicesList(ctx, &resp.Diagnostics, d.client) {
+		if deviceMatchesFilters(device, data) {
+			devices = append(devices, device)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort.SliceStable(devices, func(i, j int) bool {
+		return devices[i].SerialNumber.ValueString() < devices[j].SerialNumber.ValueString()
+	})
+	data.Devices, _ = types.ListValueFrom(ctx, DeviceDataSourceModelAttributeType(), devices)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_devices with id '%s'", data.Id.ValueString()))
+}
+
+// deviceMatchesFilters returns true when the Device matches all the filters configured in the data source.
+func deviceMatchesFilters(device DeviceDataSourceModel, data *DevicesDataSourceModel) bool {
+	if !data.Unbound.IsNull() && !data.Unbound.IsUnknown() && data.Unbound.ValueBool() != (device.NodeId.ValueString() == "") {
+		return false
+	}
+
+	filters := [][2]types.String{
+		{data.ModelName, device.ModelName},
+		{data.OsType, device.OsType},
+		{data.FabricId, device.FabricId},
+		{data.RackId, device.RackId},
+	}
+	for _, filter := range filters {
+		if !filter[0].IsNull() && !filter[0].IsUnknown() && filter[0].ValueString() != filter[1].ValueString() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,381 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FabricCablingCheckDataSource{}
+
+func NewFabricCablingCheckDataSource() datasource.DataSource {
+	return &FabricCablingCheckDataSource{}
+}
+
+// FabricCablingCheckDataSource defines the data source implementation.
+type FabricCablingCheckDataSource struct {
+	client *client.Client
+}
+
+// FabricCablingCheckDataSourceModel describes the data source data model.
+type FabricCablingCheckDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	Valid       types.Bool   `tfsdk:"valid"`
+	Connections types.List   `tfsdk:"connections"`
+	Mismatches  types.List   `tfsdk:"mismatches"`
+}
+
+// FabricCablingCheckConnectionDataSourceModel describes the LLDP verification of a Connection.
+type FabricCablingCheckConnectionDataSourceModel struct {
+	ConnectionId     types.String `tfsdk:"connection_id"`
+	LocalNodeName    types.String `tfsdk:"local_node_name"`
+	LocalPortName    types.String `tfsdk:"local_port_name"`
+	ExpectedNodeName types.String `tfsdk:"expected_node_name"`
+	ExpectedPortName types.String `tfsdk:"expected_port_name"`
+	ObservedNodeName types.String `tfsdk:"observed_node_name"`
+	ObservedPortName types.String `tfsdk:"observed_port_name"`
+	Status           types.String `tfsdk:"status"`
+}
+
+func FabricCablingCheckConnectionDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"connection_id":      types.StringType,
+			"local_node_name":    types.StringType,
+			"local_port_name":    types.StringType,
+			"expected_node_name": types.StringType,
+			"expected_port_name": types.StringType,
+			"observed_node_name": types.StringType,
+			"observed_port_name": types.StringType,
+			"status":             types.StringType,
+		},
+	}
+}
+
+// FabricCablingCheckMismatchDataSourceModel describes a Port for which the LLDP neighbor does not match the Connections.
+type FabricCablingCheckMismatchDataSourceModel struct {
+	Type             types.String `tfsdk:"type"`
+	ConnectionId     types.String `tfsdk:"connection_id"`
+	NodeName         types.String `tfsdk:"node_name"`
+	PortName         types.String `tfsdk:"port_name"`
+	ExpectedNodeName types.String `tfsdk:"expected_node_name"`
+	ExpectedPortName types.String `tfsdk:"expected_port_name"`
+	ObservedNodeName types.String `tfsdk:"observed_node_name"`
+	ObservedPortName types.String `tfsdk:"observed_port_name"`
+}
+
+func FabricCablingCheckMismatchDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":               types.StringType,
+			"connection_id":      types.StringType,
+			"node_name":          types.StringType,
+			"port_name":          types.StringType,
+			"expected_node_name": types.StringType,
+			"expected_port_name": types.StringType,
+			"observed_node_name": types.StringType,
+			"observed_port_name": types.StringType,
+		},
+	}
+}
+
+func (d *FabricCablingCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_fabric_cabling_check")
+	resp.TypeName = req.ProviderTypeName + "_fabric_cabling_check"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_fabric_cabling_check")
+}
+
+func (d *FabricCablingCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_fabric_cabling_check")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fabric Cabling Check data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/cablingCheck`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "True when the LLDP neighbors of all the Ports match the Connections of the Fabric.",
+				Computed:            true,
+			},
+			"connections": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the Connections of the Fabric with their expected and observed LLDP neighbor sorted by local Node name and port name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_id": schema.StringAttribute{
+							MarkdownDescription: "`connection_id` defines the unique identifier of a Connection.",
+							Computed:            true,
+						},
+						"local_node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node used as local side of the Connection.",
+							Computed:            true,
+						},
+						"local_port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the port on the Node used as local side of the Connection.",
+							Computed:            true,
+						},
+						"expected_node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node used as remote side of the Connection.",
+							Computed:            true,
+						},
+						"expected_port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the port on the Node used as remote side of the Connection.",
+							Computed:            true,
+						},
+						"observed_node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the host reported by LLDP on the local port of the Connection.",
+							Computed:            true,
+						},
+						"observed_port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the port reported by LLDP on the local port of the Connection.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The cabling status of the Connection.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"mismatches": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the Ports for which the LLDP neighbor does not match the Connections of the Fabric sorted by Node name and port name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of mismatch.",
+							Computed:            true,
+						},
+						"connection_id": schema.StringAttribute{
+							MarkdownDescription: "`connection_id` defines the unique identifier of the Connection expected on the Port.",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node of the Port.",
+							Computed:            true,
+						},
+						"port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Port.",
+							Computed:            true,
+						},
+						"expected_node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node expected as neighbor of the Port.",
+							Computed:            true,
+						},
+						"expected_port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the port expected as neighbor of the Port.",
+							Computed:            true,
+						},
+						"observed_node_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the host reported by LLDP on the Port.",
+							Computed:            true,
+						},
+						"observed_port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the port reported by LLDP on the Port.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_fabric_cabling_check")
+}
+
+func (d *FabricCablingCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_fabric_cabling_check")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_fabric_cabling_check")
+}
+
+func (d *FabricCablingCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_fabric_cabling_check")
+	var data *FabricCablingCheckDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/cablingCheck", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_fabric_cabling_check with id '%s'", data.Id.ValueString()))
+
+	connections, mismatches := getFabricCablingCheck(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Valid = basetypes.NewBoolValue(len(mismatches) == 0)
+	data.Connections, _ = types.ListValueFrom(ctx, FabricCablingCheckConnectionDataSourceModelAttributeType(), connections)
+	data.Mismatches, _ = types.ListValueFrom(ctx, FabricCablingCheckMismatchDataSourceModelAttributeType(), mismatches)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_fabric_cabling_check with id '%s'", data.Id.ValueString()))
+}
+
+// cablingPort identifies a port of a Node by the name of the Node and the name of the port.
+type cablingPort struct {
+	nodeName string
+	portName string
+}
+
+// getFabricCablingCheck compares the LLDP neighbor reported on both sides of each Connection of the Fabric with the
+// other side of the Connection. A Connection is MISCABLED when a side reports another neighbor, MISSING when no side
+// reports a neighbor and CABLED otherwise. Ports reporting a Node of the Fabric as LLDP neighbor without a Connection
+// are reported as UNEXPECTED.
+func getFabricCablingCheck(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId string) ([]FabricCablingCheckConnectionDataSourceModel, []FabricCablingCheckMismatchDataSourceModel) {
+	connections := make([]FabricCablingCheckConnectionDataSourceModel, 0)
+	mismatches := make([]FabricCablingCheckMismatchDataSourceModel, 0)
+
+	nodes := getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes", fabricId), "nodes")
+	if diags.HasError() {
+		return connections, mismatches
+	}
+
+	// The LLDP neighbors of all the ports of the Nodes of the Fabric
+	neighbors := map[cablingPort]cablingPort{}
+	nodeNames := []string{}
+	for _, node := range nodes {
+		nodeName := getStringFromMap(node, "name")
+		nodeNames = append(nodeNames, nodeName)
+		for _, port := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s/ports", fabricId, getStringFromMap(node, "nodeId")), "ports") {
+			if getStringFromMap(port, "lldpHost") != "" || getStringFromMap(port, "lldpPort") != "" {
+				neighbors[cablingPort{nodeName, getStringFromMap(port, "name")}] = cablingPort{getStringFromMap(port, "lldpHost"), getStringFromMap(port, "lldpPort")}
+			}
+		}
+		if diags.HasError() {
+			return connections, mismatches
+		}
+	}
+
+	connectedPorts := map[cablingPort]bool{}
+	for _, connection := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/connections", fabricId), "connections") {
+		connectionId := getStringFromMap(connection, "id")
+		localMap, _ := connection["local"].(map[string]interface{})
+		remoteMap, _ := connection["remote"].(map[string]interface{})
+		local := cablingPort{getStringFromMap(localMap, "nodeName"), getStringFromMap(localMap, "portName")}
+		remote := cablingPort{getStringFromMap(remoteMap, "nodeName"), getStringFromMap(remoteMap, "portName")}
+		connectedPorts[local], connectedPorts[remote] = true, true
+
+		localNeighbor, localSeen := neighbors[local]
+		remoteNeighbor, remoteSeen := neighbors[remote]
+		status := "CABLED"
+		if !localSeen && !remoteSeen {
+			status = "MISSING"
+			mismatches = append(mismatches, newFabricCablingCheckMismatch("MISSING", connectionId, local, remote, cablingPort{}))
+		}
+		if localSeen && !localNeighbor.matches(remote) {
+			status = "MISCABLED"
+			mismatches = append(mismatches, newFabricCablingCheckMismatch("MISCABLED", connectionId, local, remote, localNeighbor))
+		}
+		if remoteSeen && !remoteNeighbor.matches(local) {
+			status = "MISCABLED"
+			mismatches = append(mismatches, newFabricCablingCheckMismatch("MISCABLED", connectionId, remote, local, remoteNeighbor))
+		}
+
+		connections = append(connections, FabricCablingCheckConnectionDataSourceModel{
+			ConnectionId:     basetypes.NewStringValue(connectionId),
+			LocalNodeName:    basetypes.NewStringValue(local.nodeName),
+			LocalPortName:    basetypes.NewStringValue(local.portName),
+			ExpectedNodeName: basetypes.NewStringValue(remote.nodeName),
+			ExpectedPortName: basetypes.NewStringValue(remote.portName),
+			ObservedNodeName: basetypes.NewStringValue(localNeighbor.nodeName),
+			ObservedPortName: basetypes.NewStringValue(localNeighbor.portName),
+			Status:           basetypes.NewStringValue(status),
+		})
+	}
+
+	for port, neighbor := range neighbors {
+		if connectedPorts[port] {
+			continue
+		}
+		for _, nodeName := range nodeNames {
+			if neighbor.isNode(nodeName) {
+				mismatches = append(mismatches, newFabricCablingCheckMismatch("UNEXPECTED", "", port, cablingPort{}, neighbor))
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(connections, func(i, j int) bool {
+		if connections[i].LocalNodeName.ValueString() != connections[j].LocalNodeName.ValueString() {
+			return connections[i].LocalNodeName.ValueString() < connections[j].LocalNodeName.ValueString()
+		}
+		return connections[i].LocalPortName.ValueString() < connections[j].LocalPortName.ValueString()
+	})
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		if mismatches[i].NodeName.ValueString() != mismatches[j].NodeName.ValueString() {
+			return mismatches[i].NodeName.ValueString() < mismatches[j].NodeName.ValueString()
+		}
+		return mismatches[i].PortName.ValueString() < mismatches[j].PortName.ValueString()
+	})
+	return connections, mismatches
+}
+
+func newFabricCablingCheckMismatch(mismatchType, connectionId string, port, expected, observed cablingPort) FabricCablingCheckMismatchDataSourceModel {
+	return FabricCablingCheckMismatchDataSourceModel{
+		Type:             basetypes.NewStringValue(mismatchType),
+		ConnectionId:     basetypes.NewStringValue(connectionId),
+		NodeName:         basetypes.NewStringValue(port.nodeName),
+		PortName:         basetypes.NewStringValue(port.portName),
+		ExpectedNodeName: basetypes.NewStringValue(expected.nodeName),
+		ExpectedPortName: basetypes.NewStringValue(expected.portName),
+		ObservedNodeName: basetypes.NewStringValue(observed.nodeName),
+		ObservedPortName: basetypes.NewStringValue(observed.portName),
+	}
+}
+
+// matches returns true when the LLDP neighbor is the expected port. The host reported by LLDP can be a fully qualified
+// domain name and the port can use `/` instead of `_` as separator, i.e. `Ethernet1/1` for the port `Ethernet1_1`.
+func (neighbor cablingPort) matches(expected cablingPort) bool {
+	return neighbor.isNode(expected.nodeName) &&
+		strings.EqualFold(strings.ReplaceAll(neighbor.portName, "/", "_"), strings.ReplaceAll(expected.portName, "/", "_"))
+}
+
+// isNode returns true when the host reported by LLDP is the Node.
+func (neighbor cablingPort) isNode(nodeName string) bool {
+	hostName, _, _ := strings.Cut(neighbor.nodeName, ".")
+	return strings.EqualFold(hostName, nodeName)
+}
@@ -77,9 +77,10 @@ func (d *FabricDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "The country in which the Fabric is located.",
 				Computed:            true,
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_fabric")
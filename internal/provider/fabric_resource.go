@@ -45,14 +45,15 @@ type FabricResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	// Enabled     types.Bool   `tfsdk:"enabled"`
-	Topology    types.String `tfsdk:"topology"`
-	Location    types.String `tfsdk:"location"`
-	Address     types.String `tfsdk:"address"`
-	City        types.String `tfsdk:"city"`
-	Country     types.String `tfsdk:"country"`
-	Metadata    types.Object `tfsdk:"metadata"`
-	Labels      types.Set    `tfsdk:"labels"`
-	Annotations types.Set    `tfsdk:"annotations"`
+	Topology             types.String `tfsdk:"topology"`
+	Location             types.String `tfsdk:"location"`
+	Address              types.String `tfsdk:"address"`
+	City                 types.String `tfsdk:"city"`
+	Country              types.String `tfsdk:"country"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyFabricResourceModel() *FabricResourceModel {
@@ -61,14 +62,15 @@ func getEmptyFabricResourceModel() *FabricResourceModel {
 		Name:        basetypes.NewStringNull(),
 		Description: basetypes.NewStringNull(),
 		// Enabled:     basetypes.NewBoolValue(true),
-		Topology:    basetypes.NewStringNull(),
-		Location:    basetypes.NewStringNull(),
-		Address:     basetypes.NewStringNull(),
-		City:        basetypes.NewStringNull(),
-		Country:     basetypes.NewStringNull(),
-		Metadata:    basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Topology:             basetypes.NewStringNull(),
+		Location:             basetypes.NewStringNull(),
+		Address:              basetypes.NewStringNull(),
+		City:                 basetypes.NewStringNull(),
+		Country:              basetypes.NewStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -118,6 +120,10 @@ func getNewFabricResourceModelFromData(data *FabricResourceModel) *FabricResourc
 	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
 		newFabric.Annotations = data.Annotations
 	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newFabric.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
 	return newFabric
 }
 
@@ -214,9 +220,10 @@ func (r *FabricResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_fabric")
@@ -332,6 +339,16 @@ func (r *FabricResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s", data.Id.ValueString()), "hyperfabric_fabric", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s", data.Id.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s", data.Id.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -357,6 +374,11 @@ func (r *FabricResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_fabric with id '%s'", data.Id.ValueString()))
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s", data.Id.ValueString()), "hyperfabric_fabric", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s", data.Id.ValueString()), "DELETE", nil)
 	if resp.Diagnostics.HasError() {
 		return
@@ -405,9 +427,9 @@ func getAndSetFabricAttributes(ctx context.Context, diags *diag.Diagnostics, cli
 			} else if attributeName == "metadata" {
 				newFabric.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newFabric.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newFabric.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newFabric.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newFabric.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
@@ -456,7 +478,7 @@ func getFabricJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *Fa
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
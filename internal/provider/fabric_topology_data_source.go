@@ -0,0 +1,320 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FabricTopologyDataSource{}
+
+func NewFabricTopologyDataSource() datasource.DataSource {
+	return &FabricTopologyDataSource{}
+}
+
+// FabricTopologyDataSource defines the data source implementation.
+type FabricTopologyDataSource struct {
+	client *client.Client
+}
+
+// FabricTopologyDataSourceModel describes the data source data model.
+type FabricTopologyDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	FabricId types.String `tfsdk:"fabric_id"`
+	Json     types.String `tfsdk:"json"`
+	Dot      types.String `tfsdk:"dot"`
+	Mermaid  types.String `tfsdk:"mermaid"`
+}
+
+// FabricTopology is the graph of the Nodes of a Fabric and of the Connections between them.
+type FabricTopology struct {
+	Fabric string               `json:"fabric"`
+	Nodes  []FabricTopologyNode `json:"nodes"`
+	Links  []FabricTopologyLink `json:"links"`
+}
+
+// FabricTopologyNode describes a Node in the topology of a Fabric.
+type FabricTopologyNode struct {
+	Id        string                   `json:"id"`
+	Name      string                   `json:"name"`
+	ModelName string                   `json:"modelName"`
+	Roles     []string                 `json:"roles"`
+	Breakouts []FabricTopologyBreakout `json:"breakouts"`
+}
+
+// FabricTopologyBreakout describes the Breakouts of the ports of a Node in the topology of a Fabric.
+type FabricTopologyBreakout struct {
+	Name      string   `json:"name"`
+	Mode      string   `json:"mode"`
+	Ports     []string `json:"ports"`
+	Breakouts []string `json:"breakouts"`
+}
+
+// FabricTopologyLink describes a Connection between two Nodes in the topology of a Fabric.
+type FabricTopologyLink struct {
+	Id     string                 `json:"id"`
+	Local  FabricTopologyEndpoint `json:"local"`
+	Remote FabricTopologyEndpoint `json:"remote"`
+}
+
+// FabricTopologyEndpoint describes the port of a Node used as local or remote side of a Connection.
+type FabricTopologyEndpoint struct {
+	Node string `json:"node"`
+	Port string `json:"port"`
+}
+
+func (d *FabricTopologyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_fabric_topology")
+	resp.TypeName = req.ProviderTypeName + "_fabric_topology"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_fabric_topology")
+}
+
+func (d *FabricTopologyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_fabric_topology")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fabric Topology data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/topology`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The topology of the Fabric rendered as JSON.",
+				Computed:            true,
+			},
+			"dot": schema.StringAttribute{
+				MarkdownDescription: "The topology of the Fabric rendered in the Graphviz DOT language.",
+				Computed:            true,
+			},
+			"mermaid": schema.StringAttribute{
+				MarkdownDescription: "The topology of the Fabric rendered as a Mermaid flowchart.",
+				Computed:            true,
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_fabric_topology")
+}
+
+func (d *FabricTopologyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_fabric_topology")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_fabric_topology")
+}
+
+func (d *FabricTopologyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_fabric_topology")
+	var data *FabricTopologyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/topology", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_fabric_topology with id '%s'", data.Id.ValueString()))
+
+	topology := getFabricTopology(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topologyJson, err := json.MarshalIndent(topology, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to render the Fabric topology",
+			fmt.Sprintf("The topology of the Fabric '%s' cannot be rendered as JSON: %s", data.FabricId.ValueString(), err),
+		)
+		return
+	}
+	data.Json = basetypes.NewStringValue(string(topologyJson))
+	data.Dot = basetypes.NewStringValue(topology.dot())
+	data.Mermaid = basetypes.NewStringValue(topology.mermaid())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_fabric_topology with id '%s'", data.Id.ValueString()))
+}
+
+// getFabricTopology returns the Nodes of the Fabric with their Breakouts sorted by role and name, and the Connections
+// between them sorted by local Node and port name.
+func getFabricTopology(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId string) FabricTopology {
+	topology := FabricTopology{Fabric: fabricId, Nodes: []FabricTopologyNode{}, Links: []FabricTopologyLink{}}
+
+	fabric := getEmptyFabricResourceModel()
+	fabric.Id = basetypes.NewStringValue(fabricId)
+	getAndSetFabricAttributes(ctx, diags, client, fabric)
+	if diags.HasError() {
+		return topology
+	}
+	if fabric.Name.ValueString() != "" {
+		topology.Fabric = fabric.Name.ValueString()
+	}
+
+	for _, node := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes", fabricId), "nodes") {
+		topologyNode := FabricTopologyNode{
+			Id:        getStringFromMap(node, "nodeId"),
+			Name:      getStringFromMap(node, "name"),
+			ModelName: getStringFromMap(node, "modelName"),
+			Roles:     getStringsFromMap(node, "roles"),
+			Breakouts: []FabricTopologyBreakout{},
+		}
+		for _, breakout := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s/breakouts", fabricId, topologyNode.Id), "breakouts") {
+			topologyNode.Breakouts = append(topologyNode.Breakouts, FabricTopologyBreakout{
+				Name:      getStringFromMap(breakout, "name"),
+				Mode:      getStringFromMap(breakout, "mode"),
+				Ports:     getStringsFromMap(breakout, "ports"),
+				Breakouts: getStringsFromMap(breakout, "breakouts"),
+			})
+		}
+		sort.SliceStable(topologyNode.Breakouts, func(i, j int) bool {
+			return topologyNode.Breakouts[i].Name < topologyNode.Breakouts[j].Name
+		})
+		topology.Nodes = append(topology.Nodes, topologyNode)
+	}
+	if diags.HasError() {
+		return topology
+	}
+
+	for _, connection := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/connections", fabricId), "connections") {
+		local, _ := connection["local"].(map[string]interface{})
+		remote, _ := connection["remote"].(map[string]interface{})
+		topology.Links = append(topology.Links, FabricTopologyLink{
+			Id:     getStringFromMap(connection, "id"),
+			Local:  FabricTopologyEndpoint{Node: getStringFromMap(local, "nodeName"), Port: getStringFromMap(local, "portName")},
+			Remote: FabricTopologyEndpoint{Node: getStringFromMap(remote, "nodeName"), Port: getStringFromMap(remote, "portName")},
+		})
+	}
+
+	// Spines are listed before the other Nodes to be drawn at the top of the diagrams
+	sort.SliceStable(topology.Nodes, func(i, j int) bool {
+		spineI, spineJ := ContainsString(topology.Nodes[i].Roles, "SPINE"), ContainsString(topology.Nodes[j].Roles, "SPINE")
+		if spineI != spineJ {
+			return spineI
+		}
+		return topology.Nodes[i].Name < topology.Nodes[j].Name
+	})
+	sort.SliceStable(topology.Links, func(i, j int) bool {
+		if topology.Links[i].Local.Node != topology.Links[j].Local.Node {
+			return topology.Links[i].Local.Node < topology.Links[j].Local.Node
+		}
+		return topology.Links[i].Local.Port < topology.Links[j].Local.Port
+	})
+	return topology
+}
+
+// labelLines returns the lines of the label of a Node in the diagrams.
+func (node FabricTopologyNode) labelLines() []string {
+	lines := []string{node.Name}
+	if len(node.Roles) > 0 {
+		lines = append(lines, strings.Join(node.Roles, ", "))
+	}
+	if node.ModelName != "" {
+		lines = append(lines, node.ModelName)
+	}
+	for _, breakout := range node.Breakouts {
+		lines = append(lines, fmt.Sprintf("%s: %s", strings.Join(breakout.Ports, ", "), breakout.Mode))
+	}
+	return lines
+}
+
+// dot renders the topology as an undirected graph in the Graphviz DOT language.
+func (topology FabricTopology) dot() string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "graph \"%s\" {\n", escape.Replace(topology.Fabric))
+	builder.WriteString("  node [shape=box];\n")
+
+	spines := []string{}
+	for _, node := range topology.Nodes {
+		lines := node.labelLines()
+		for index := range lines {
+			lines[index] = escape.Replace(lines[index])
+		}
+		fmt.Fprintf(&builder, "  \"%s\" [label=\"%s\"];\n", escape.Replace(node.Name), strings.Join(lines, `\n`))
+		if ContainsString(node.Roles, "SPINE") {
+			spines = append(spines, fmt.Sprintf("\"%s\"", escape.Replace(node.Name)))
+		}
+	}
+	if len(spines) > 0 {
+		fmt.Fprintf(&builder, "  { rank=same; %s; }\n", strings.Join(spines, "; "))
+	}
+
+	for _, link := range topology.Links {
+		fmt.Fprintf(&builder, "  \"%s\" -- \"%s\" [taillabel=\"%s\", headlabel=\"%s\"];\n",
+			escape.Replace(link.Local.Node), escape.Replace(link.Remote.Node), escape.Replace(link.Local.Port), escape.Replace(link.Remote.Port))
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// mermaid renders the topology as a top-down Mermaid flowchart. The Nodes are identified by their index because the
+// names of the Nodes can contain characters which are not allowed in Mermaid identifiers.
+func (topology FabricTopology) mermaid() string {
+	escape := strings.NewReplacer(`"`, "#quot;")
+	var builder strings.Builder
+	builder.WriteString("flowchart TD\n")
+
+	identifiers := map[string]string{}
+	identifier := func(nodeName string) string {
+		if _, ok := identifiers[nodeName]; !ok {
+			identifiers[nodeName] = fmt.Sprintf("n%d", len(identifiers))
+			fmt.Fprintf(&builder, "  %s[\"%s\"]\n", identifiers[nodeName], escape.Replace(nodeName))
+		}
+		return identifiers[nodeName]
+	}
+
+	for _, node := range topology.Nodes {
+		identifiers[node.Name] = fmt.Sprintf("n%d", len(identifiers))
+		lines := node.labelLines()
+		for index := range lines {
+			lines[index] = escape.Replace(lines[index])
+		}
+		fmt.Fprintf(&builder, "  %s[\"%s\"]\n", identifiers[node.Name], strings.Join(lines, "<br/>"))
+	}
+
+	for _, link := range topology.Links {
+		localId, remoteId := identifier(link.Local.Node), identifier(link.Remote.Node)
+		fmt.Fprintf(&builder, "  %s ---|\"%s - %s\"| %s\n", localId, escape.Replace(link.Local.Port), escape.Replace(link.Remote.Port), remoteId)
+	}
+	return builder.String()
+}
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+// testFabricTopology returns a topology as sorted by getFabricTopology, with a Breakout, names which must be escaped
+// and a Connection to a Node which is not part of the Fabric.
+func testFabricTopology() FabricTopology {
+	return FabricTopology{
+		Fabric: `fab "1"`,
+		Nodes: []FabricTopologyNode{
+			{
+				Id:        "0a1b2c3d-0000-4000-8000-000000000001",
+				Name:      "spine1",
+				ModelName: "HF6100-32D",
+				Roles:     []string{"SPINE"},
+				Breakouts: []FabricTopologyBreakout{
+					{Name: "breakout1", Mode: "4x100G", Ports: []string{"Ethernet1_1"}, Breakouts: []string{"Ethernet1_1_1", "Ethernet1_1_2", "Ethernet1_1_3", "Ethernet1_1_4"}},
+				},
+			},
+			{
+				Id:        "0a1b2c3d-0000-4000-8000-000000000002",
+				Name:      "leaf1",
+				ModelName: "HF6100-60L4D",
+				Roles:     []string{"LEAF"},
+				Breakouts: []FabricTopologyBreakout{},
+			},
+			{
+				Id:        "0a1b2c3d-0000-4000-8000-000000000003",
+				Name:      `leaf "2"`,
+				Roles:     []string{"LEAF"},
+				Breakouts: []FabricTopologyBreakout{},
+			},
+		},
+		Links: []FabricTopologyLink{
+			{
+				Id:     "0a1b2c3d-0000-4000-8000-000000000011",
+				Local:  FabricTopologyEndpoint{Node: "leaf1", Port: "Ethernet1_61"},
+				Remote: FabricTopologyEndpoint{Node: "spine1", Port: "Ethernet1_1_1"},
+			},
+			{
+				Id:     "0a1b2c3d-0000-4000-8000-000000000012",
+				Local:  FabricTopologyEndpoint{Node: "leaf1", Port: "Ethernet1_62"},
+				Remote: FabricTopologyEndpoint{Node: "external", Port: "Ethernet1"},
+			},
+		},
+	}
+}
+
+func TestFabricTopologyDot(t *testing.T) {
+	expected := `graph "fab \"1\"" {
+  node [shape=box];
+  "spine1" [label="spine1\nSPINE\nHF6100-32D\nEthernet1_1: 4x100G"];
+  "leaf1" [label="leaf1\nLEAF\nHF6100-60L4D"];
+  "leaf \"2\"" [label="leaf \"2\"\nLEAF"];
+  { rank=same; "spine1"; }
+  "leaf1" -- "spine1" [taillabel="Ethernet1_61", headlabel="Ethernet1_1_1"];
+  "leaf1" -- "external" [taillabel="Ethernet1_62", headlabel="Ethernet1"];
+}
+`
+	if dot := testFabricTopology().dot(); dot != expected {
+		t.Errorf("expected the topology to be rendered as:\n%s\ngot:\n%s", expected, dot)
+	}
+}
+
+func TestFabricTopologyMermaid(t *testing.T) {
+	expected := `flowchart TD
+  n0["spine1<br/>SPINE<br/>HF6100-32D<br/>Ethernet1_1: 4x100G"]
+  n1["leaf1<br/>LEAF<br/>HF6100-60L4D"]
+  n2["leaf #quot;2#quot;<br/>LEAF"]
+  n1 ---|"Ethernet1_61 - Ethernet1_1_1"| n0
+  n3["external"]
+  n1 ---|"Ethernet1_62 - Ethernet1"| n3
+`
+	if mermaid := testFabricTopology().mermaid(); mermaid != expected {
+		t.Errorf("expected the topology to be rendered as:\n%s\ngot:\n%s", expected, mermaid)
+	}
+}
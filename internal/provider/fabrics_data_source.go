@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FabricsDataSource{}
+
+func NewFabricsDataSource() datasource.DataSource {
+	return &FabricsDataSource{}
+}
+
+// FabricsDataSource defines the data source implementation.
+type FabricsDataSource struct {
+	client *client.Client
+}
+
+// FabricsDataSourceModel describes the data source data model.
+type FabricsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	Fabrics     types.List   `tfsdk:"fabrics"`
+}
+
+// FabricSummaryDataSourceModel describes a Fabric in the list of Fabrics.
+type FabricSummaryDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Topology    types.String `tfsdk:"topology"`
+	Location    types.String `tfsdk:"location"`
+	Address     types.String `tfsdk:"address"`
+	City        types.String `tfsdk:"city"`
+	Country     types.String `tfsdk:"country"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Set    `tfsdk:"annotations"`
+}
+
+func FabricSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":          types.StringType,
+			"name":        types.StringType,
+			"description": types.StringType,
+			"topology":    types.StringType,
+			"location":    types.StringType,
+			"address":     types.StringType,
+			"city":        types.StringType,
+			"country":     types.StringType,
+			"labels":      types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"annotations": types.SetType{ElemType: AnnotationResourceModelAttributeType()},
+		},
+	}
+}
+
+func (d *FabricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_fabrics")
+	resp.TypeName = req.ProviderTypeName + "_fabrics"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_fabrics")
+}
+
+func (d *FabricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_fabrics")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fabrics data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to `fabrics`.",
+				Computed:            true,
+			},
+			"name_regex":  getNameRegexFilterSchemaAttribute("Fabrics"),
+			"labels":      getLabelsFilterSchemaAttribute("Fabrics"),
+			"annotations": getAnnotationsFilterSchemaAttribute("Fabrics"),
+			"fabrics": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Fabrics matching the filters sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Fabric.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Fabric.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the Fabric.",
+							Computed:            true,
+						},
+						"topology": schema.StringAttribute{
+							MarkdownDescription: "The topology used by the Fabric.",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "The location of the Fabric.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The address where the Fabric is located.",
+							Computed:            true,
+						},
+						"city": schema.StringAttribute{
+							MarkdownDescription: "The city where the Fabric is located.",
+							Computed:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "The country in which the Fabric is located.",
+							Computed:            true,
+						},
+						"labels":      getLabelsDataSourceSchemaAttribute(),
+						"annotations": getAnnotationsDataSourceSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_fabrics")
+}
+
+func (d *FabricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_fabrics")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_fabrics")
+}
+
+func (d *FabricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_fabrics")
+	var data *FabricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue("fabrics")
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_fabrics with name regex '%s'", data.NameRegex.ValueString()))
+
+	filters := getObjectFilters(ctx, data.NameRegex, data.Labels, data.Annotations, basetypes.NewSetNull(types.StringType), basetypes.NewBoolNull())
+	fabrics := getFabricsList(ctx, &resp.Diagnostics, d.client, filters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Fabrics, _ = types.ListValueFrom(ctx, FabricSummaryDataSourceModelAttributeType(), fabrics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_fabrics with id '%s'", data.Id.ValueString()))
+}
+
+// getFabricsList returns the Fabrics matching the filters sorted by name.
+func getFabricsList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, filters ObjectFilters) []FabricSummaryDataSourceModel {
+	fabrics := make([]FabricSummaryDataSourceModel, 0)
+	for _, fabric := range getObjectsList(ctx, diags, client, "/api/v1/fabrics", "fabrics") {
+		if !filters.matches(fabric, "name") {
+			continue
+		}
+		fabrics = append(fabrics, FabricSummaryDataSourceModel{
+			Id:          basetypes.NewStringValue(getStringFromMap(fabric, "fabricId")),
+			Name:        basetypes.NewStringValue(getStringFromMap(fabric, "name")),
+			Description: basetypes.NewStringValue(getStringFromMap(fabric, "description")),
+			Topology:    basetypes.NewStringValue(getStringFromMap(fabric, "topology")),
+			Location:    basetypes.NewStringValue(getStringFromMap(fabric, "location")),
+			Address:     basetypes.NewStringValue(getStringFromMap(fabric, "address")),
+			City:        basetypes.NewStringValue(getStringFromMap(fabric, "city")),
+			Country:     basetypes.NewStringValue(getStringFromMap(fabric, "country")),
+			Labels:      getObjectLabelsSet(ctx, fabric),
+			Annotations: getObjectAnnotationsSet(ctx, fabric),
+		})
+	}
+
+	sort.SliceStable(fabrics, func(i, j int) bool {
+		return fabrics[i].Name.ValueString() < fabrics[j].Name.ValueString()
+	})
+	return fabrics
+}
@@ -0,0 +1,201 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ObjectFilters describes the filters of the plural data sources. An object is returned when it matches all the
+// configured filters.
+type ObjectFilters struct {
+	NameRegex   *regexp.Regexp
+	Labels      []string
+	Annotations map[string]string
+	Roles       []string
+	Enabled     *bool
+}
+
+// getObjectFilters returns the filters configured in a plural data source. Null values are used for the filters which
+// are not supported by the data source.
+func getObjectFilters(ctx context.Context, nameRegex basetypes.StringValue, labels basetypes.SetValue, annotations basetypes.MapValue, roles basetypes.SetValue, enabled basetypes.BoolValue) ObjectFilters {
+	filters := ObjectFilters{
+		Labels:      getSetStringJsonPayload(ctx, labels),
+		Annotations: map[string]string{},
+		Roles:       getSetStringJsonPayload(ctx, roles),
+	}
+
+	if !nameRegex.IsNull() && !nameRegex.IsUnknown() {
+		// The regular expression is already validated by the IsRegex validator of the schema
+		filters.NameRegex, _ = regexp.Compile(nameRegex.ValueString())
+	}
+
+	if !annotations.IsNull() && !annotations.IsUnknown() {
+		annotations.ElementsAs(ctx, &filters.Annotations, false)
+	}
+
+	if !enabled.IsNull() && !enabled.IsUnknown() {
+		enabledValue := enabled.ValueBool()
+		filters.Enabled = &enabledValue
+	}
+	return filters
+}
+
+// matches returns true when the object returned by the API matches all the filters. The name of the object is found
+// under nameKey in the object.
+func (f ObjectFilters) matches(object map[string]interface{}, nameKey string) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(getStringFromMap(object, nameKey)) {
+		return false
+	}
+
+	objectLabels := getObjectLabels(object)
+	for _, label := range f.Labels {
+		if !ContainsString(objectLabels, label) {
+			return false
+		}
+	}
+
+	if len(f.Annotations) > 0 {
+		objectAnnotations := map[string]string{}
+		if annotations, ok := object["annotations"].([]interface{}); ok {
+			for _, annotation := range annotations {
+				if annotationMap, ok := annotation.(map[string]interface{}); ok && isExposedAnnotation(getStringFromMap(annotationMap, "name")) {
+					objectAnnotations[getStringFromMap(annotationMap, "name")] = getStringFromMap(annotationMap, "value")
+				}
+			}
+		}
+		for name, value := range f.Annotations {
+			if objectValue, ok := objectAnnotations[name]; !ok || objectValue != value {
+				return false
+			}
+		}
+	}
+
+	if len(f.Roles) > 0 {
+		objectRoles := getStringsFromMap(object, "roles")
+		matchesRole := false
+		for _, role := range f.Roles {
+			if ContainsString(objectRoles, role) {
+				matchesRole = true
+				break
+			}
+		}
+		if !matchesRole {
+			return false
+		}
+	}
+
+	if f.Enabled != nil {
+		if enabled, ok := object["enabled"].(bool); !ok || enabled != *f.Enabled {
+			return false
+		}
+	}
+	return true
+}
+
+// getObjectsList returns the list of objects found under listKey in the response of a GET request to path. Unlike
+// getFabricObjectsList, failures are added to the diagnostics.
+func getObjectsList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, path, listKey string) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0)
+	requestData := DoRestRequest(ctx, diags, client, path, "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return objects
+	}
+
+	if responseMap, ok := requestData.Data().(map[string]interface{}); ok {
+		if list, ok := responseMap[listKey].([]interface{}); ok {
+			for _, object := range list {
+				if objectMap, ok := object.(map[string]interface{}); ok {
+					objects = append(objects, objectMap)
+				}
+			}
+		}
+	}
+	return objects
+}
+
+func getNameRegexFilterSchemaAttribute(objectName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("A regular expression to only return the %s with a matching name.", objectName),
+		Optional:            true,
+		Validators: []validator.String{
+			IsRegex(),
+		},
+	}
+}
+
+func getLabelsFilterSchemaAttribute(objectName string) schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: fmt.Sprintf("A set of labels to only return the %s with all these labels.", objectName),
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+func getAnnotationsFilterSchemaAttribute(objectName string) schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: fmt.Sprintf("A map of annotation names and values to only return the %s with all these annotations.", objectName),
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+func getRolesFilterSchemaAttribute(objectName string, roles []string) schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: fmt.Sprintf("A set of roles to only return the %s with at least one of these roles.", objectName),
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(roles...)),
+		},
+	}
+}
+
+func getEnabledFilterSchemaAttribute(objectName string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("The enabled state to only return the enabled or the disabled %s.", objectName),
+		Optional:            true,
+	}
+}
+
+// getObjectLabels returns the labels of an object returned by the API which are exposed by the plural data sources,
+// so the labels filter matches the same labels as the ones displayed.
+func getObjectLabels(object map[string]interface{}) []string {
+	labels := make([]string, 0)
+	for _, label := range getStringsFromMap(object, "labels") {
+		if !isIgnoredLabel(label) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// getObjectLabelsSet returns the labels of an object returned by the API as exposed by the plural data sources.
+func getObjectLabelsSet(ctx context.Context, object map[string]interface{}) basetypes.SetValue {
+	labels := make([]interface{}, 0)
+	for _, label := range getObjectLabels(object) {
+		labels = append(labels, label)
+	}
+	return NewSetString(ctx, labels)
+}
+
+// getObjectAnnotationsSet returns the annotations of an object returned by the API as exposed by the plural data sources.
+func getObjectAnnotationsSet(ctx context.Context, object map[string]interface{}) basetypes.SetValue {
+	annotations, _ := object["annotations"].([]interface{})
+	return NewAnnotationsSet(ctx, annotations, basetypes.NewSetNull(AnnotationResourceModelAttributeType()), basetypes.NewBoolNull())
+}
@@ -7,12 +7,28 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Set by the provider configuration, when globalIgnoreExternalLabels is true only the labels and annotations declared
+// in the configuration are managed, unless overridden by the ignore_external_labels attribute of a resource. Labels and
+// annotation names starting with one of globalIgnoreLabelPrefixes are never managed. Unmanaged labels and annotations
+// are hidden from the state and preserved during updates.
+var globalIgnoreExternalLabels bool
+var globalIgnoreLabelPrefixes []string
+
 func getLabelsSchemaAttribute() schema.SetAttribute {
 	return schema.SetAttribute{
 		MarkdownDescription: `A set of user-defined labels for searching and locating objects.`,
@@ -32,3 +48,131 @@ func getLabelsDataSourceSchemaAttribute() schema.SetAttribute {
 		ElementType:         types.StringType,
 	}
 }
+
+func getIgnoreExternalLabelsSchemaAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Only manage the `labels` and `annotations` declared in the configuration, labels and annotations added by other tools or in the GUI are not reported as drift and are preserved on update. Overrides the `ignore_external_labels` attribute of the provider when set.",
+		Optional:            true,
+	}
+}
+
+func getIgnoreExternalLabelsDataSourceSchemaAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Only used by the resource. The data source reports all the `labels` and `annotations` that do not match the `ignore_label_prefixes` attribute of the provider.",
+		Optional:            true,
+	}
+}
+
+// isIgnoringExternalLabels returns the ignore_external_labels attribute of a resource, or the provider setting when the
+// attribute is not set.
+func isIgnoringExternalLabels(ignoreExternalLabels basetypes.BoolValue) bool {
+	if ignoreExternalLabels.IsNull() || ignoreExternalLabels.IsUnknown() {
+		return globalIgnoreExternalLabels
+	}
+	return ignoreExternalLabels.ValueBool()
+}
+
+// isIgnoredLabel returns true when the label or annotation name starts with one of the ignored prefixes.
+func isIgnoredLabel(label string) bool {
+	for _, prefix := range globalIgnoreLabelPrefixes {
+		if prefix != "" && strings.HasPrefix(label, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isManagedLabel returns true when the label or annotation name should be stored in the state. When external labels
+// are ignored, only the labels found in the declared set are managed unless nothing has been declared yet.
+func isManagedLabel(label string, declared []string, declaredKnown, ignoreExternalLabels bool) bool {
+	if isIgnoredLabel(label) {
+		return false
+	}
+	return !ignoreExternalLabels || !declaredKnown || ContainsString(declared, label)
+}
+
+// isExternalLabel returns true when the label or annotation name found on the object is not managed by the
+// provider and should be preserved during an update. Without known state, such as when a Node Port is configured
+// for the first time, all the labels found on the object are external.
+func isExternalLabel(label string, state []string, stateKnown, ignoreExternalLabels bool) bool {
+	if isIgnoredLabel(label) {
+		return true
+	}
+	return ignoreExternalLabels && (!stateKnown || !ContainsString(state, label))
+}
+
+func NewLabelsSet(ctx context.Context, data []interface{}, declared basetypes.SetValue, ignoreExternalLabels basetypes.BoolValue) basetypes.SetValue {
+	declaredLabels := getSetStringJsonPayload(ctx, declared)
+	labels := make([]interface{}, 0)
+	for _, label := range data {
+		if isManagedLabel(label.(string), declaredLabels, !declared.IsNull() && !declared.IsUnknown(), isIgnoringExternalLabels(ignoreExternalLabels)) {
+			labels = append(labels, label)
+		}
+	}
+	return NewSetString(ctx, labels)
+}
+
+// preserveExternalLabelsAndAnnotations adds the labels and annotations of the object at objectPath that are not
+// managed by the provider to the update payload, so they are not removed from the object. The owner stored in the
+// managed-by annotation of the object is preserved as well.
+func preserveExternalLabelsAndAnnotations(ctx context.Context, diags *diag.Diagnostics, client *client.Client, objectPath string, jsonPayload *gabs.Container, stateLabels, stateAnnotations basetypes.SetValue, ignoreExternalLabels basetypes.BoolValue) {
+	if (!isIgnoringExternalLabels(ignoreExternalLabels) && len(globalIgnoreLabelPrefixes) == 0 && globalLabel == "") || jsonPayload == nil {
+		return
+	}
+
+	requestData := DoRestRequest(ctx, diags, client, objectPath, "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return
+	}
+
+	attributes, ok := requestData.Data().(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotationsFromAttributes(ctx, objectPath, jsonPayload, attributes, stateLabels, stateAnnotations, ignoreExternalLabels)
+}
+
+// preserveExternalLabelsAndAnnotationsFromAttributes is similar to preserveExternalLabelsAndAnnotations but uses the
+// already retrieved attributes of the object at objectPath.
+func preserveExternalLabelsAndAnnotationsFromAttributes(ctx context.Context, objectPath string, jsonPayload *gabs.Container, attributes map[string]interface{}, stateLabels, stateAnnotations basetypes.SetValue, ignoreExternalLabels basetypes.BoolValue) {
+	if jsonPayload == nil || attributes == nil {
+		return
+	}
+
+	preserveObjectOwner(jsonPayload, attributes)
+	if !isIgnoringExternalLabels(ignoreExternalLabels) && len(globalIgnoreLabelPrefixes) == 0 {
+		return
+	}
+
+	if remoteLabels, ok := attributes["labels"].([]interface{}); ok {
+		labels, _ := jsonPayload.Path("labels").Data().([]interface{})
+		stateLabelNames := getSetStringJsonPayload(ctx, stateLabels)
+		for _, remoteLabel := range remoteLabels {
+			label, ok := remoteLabel.(string)
+			if ok && !containsInterface(labels, label) && isExternalLabel(label, stateLabelNames, !stateLabels.IsNull() && !stateLabels.IsUnknown(), isIgnoringExternalLabels(ignoreExternalLabels)) {
+				tflog.Debug(ctx, fmt.Sprintf("Preserving external label '%s' of '%s'", label, objectPath))
+				labels = append(labels, label)
+			}
+		}
+		jsonPayload.Set(labels, "labels")
+	}
+
+	if remoteAnnotations, ok := attributes["annotations"].([]interface{}); ok {
+		annotations, _ := jsonPayload.Path("annotations").Data().([]interface{})
+		for _, annotation := range getExternalAnnotationsJsonPayload(ctx, remoteAnnotations, annotations, stateAnnotations, isIgnoringExternalLabels(ignoreExternalLabels)) {
+			tflog.Debug(ctx, fmt.Sprintf("Preserving external annotation '%s' of '%s'", annotation["name"], objectPath))
+			annotations = append(annotations, annotation)
+		}
+		jsonPayload.Set(annotations, "annotations")
+	}
+}
+
+func containsInterface(values []interface{}, matchString string) bool {
+	for _, value := range values {
+		if stringValue, ok := value.(string); ok && stringValue == matchString {
+			return true
+		}
+	}
+	return false
+}
@@ -10,23 +10,30 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type MemberResourceModel struct {
-	PortName types.String  `tfsdk:"port_name"`
-	NodeId   types.String  `tfsdk:"node_id"`
-	NodeName types.String  `tfsdk:"node_name"`
-	VlanId   types.Float64 `tfsdk:"vlan_id"`
-	// Untagged types.Bool	`tfsdk:"untagged"`
+	PortName types.String `tfsdk:"port_name"`
+	NodeId   types.String `tfsdk:"node_id"`
+	NodeName types.String `tfsdk:"node_name"`
+	VlanId   types.Int64  `tfsdk:"vlan_id"`
+	Untagged types.Bool   `tfsdk:"untagged"`
 }
 
 // {
@@ -45,8 +52,8 @@ func MemberResourceModelAttributeType() types.ObjectType {
 			"port_name": types.StringType,
 			"node_id":   types.StringType,
 			"node_name": types.StringType,
-			"vlan_id":   types.Float64Type,
-			// "untagged":  types.BoolType,
+			"vlan_id":   types.Int64Type,
+			"untagged":  types.BoolType,
 		},
 	}
 }
@@ -56,8 +63,8 @@ func getEmptyMemberResourceModel() *MemberResourceModel {
 		PortName: basetypes.NewStringNull(),
 		NodeId:   basetypes.NewStringNull(),
 		NodeName: basetypes.NewStringNull(),
-		VlanId:   basetypes.NewFloat64Null(),
-		// Untagged: basetypes.NewBoolNull(),
+		VlanId:   basetypes.NewInt64Null(),
+		Untagged: basetypes.NewBoolNull(),
 	}
 }
 
@@ -80,9 +87,9 @@ func getNewMemberResourceModelFromData(data *MemberResourceModel) *MemberResourc
 		newMember.VlanId = data.VlanId
 	}
 
-	// if !data.Untagged.IsNull() && !data.Untagged.IsUnknown() {
-	// 	newMember.Untagged = data.Untagged
-	// }
+	if !data.Untagged.IsNull() && !data.Untagged.IsUnknown() {
+		newMember.Untagged = data.Untagged
+	}
 
 	return newMember
 }
@@ -106,7 +113,10 @@ func getMembersSchemaAttribute() schema.SetNestedAttribute {
 						SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 					},
 					// Default:             stringdefault.StaticString("*"),
-					MarkdownDescription: `The name of the port or "*" for all ports on a node or all nodes.`,
+					Validators: []validator.String{
+						IsPortNameOrPortChannelOrWildcard(),
+					},
+					MarkdownDescription: `The name of the port or port channel, or "*" for all ports on a node or all nodes.`,
 				},
 				"node_id": schema.StringAttribute{
 					Optional: true,
@@ -126,26 +136,26 @@ func getMembersSchemaAttribute() schema.SetNestedAttribute {
 					},
 					MarkdownDescription: `The name of a node in the Fabric.`,
 				},
-				"vlan_id": schema.Float64Attribute{
+				"vlan_id": schema.Int64Attribute{
 					Optional: true,
 					Computed: true,
-					PlanModifiers: []planmodifier.Float64{
-						float64planmodifier.UseStateForUnknown(),
-						SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+						SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+					},
+					Validators: []validator.Int64{
+						int64validator.Between(1, 4094),
 					},
 					MarkdownDescription: `The VLAN ID used as encapsulation for the traffic on this port for this VNI.`,
 				},
-				// "untagged": schema.BoolAttribute{
-				// 	Optional: true,
-				// 	Computed: true,
-				// 	PlanModifiers: []planmodifier.Bool{
-				// 		boolplanmodifier.UseStateForUnknown(),
-				// 	},
-				// 	// Validators: []validator.String{
-				// 	// 	MakeStringRequired(),
-				// 	// },
-				// 	MarkdownDescription: `The untagged state for the traffic on this port for this VNI.`,
-				// },
+				"untagged": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+					MarkdownDescription: `The untagged state for the traffic on this port for this VNI. Defaults to true when vlan_id is not set.`,
+				},
 			},
 		},
 	}
@@ -159,7 +169,7 @@ func getMembersDataSourceSchemaAttribute() schema.SetNestedAttribute {
 			Attributes: map[string]schema.Attribute{
 				"port_name": schema.StringAttribute{
 					Computed:            true,
-					MarkdownDescription: `The name of the port or "*" for all ports on a node or all nodes.`,
+					MarkdownDescription: `The name of the port or port channel, or "*" for all ports on a node or all nodes.`,
 				},
 				"node_id": schema.StringAttribute{
 					Computed:            true,
@@ -169,14 +179,14 @@ func getMembersDataSourceSchemaAttribute() schema.SetNestedAttribute {
 					Computed:            true,
 					MarkdownDescription: `The name of a node in the Fabric.`,
 				},
-				"vlan_id": schema.Float64Attribute{
+				"vlan_id": schema.Int64Attribute{
 					Computed:            true,
 					MarkdownDescription: `The VLAN ID used as encapsulation for the traffic on this port for this VNI.`,
 				},
-				// "untagged": schema.BoolAttribute{
-				// 	Computed:            true,
-				// 	MarkdownDescription: `The untagged state for the traffic on this port for this VNI.`,
-				// },
+				"untagged": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: `The untagged state for the traffic on this port for this VNI.`,
+				},
 			},
 		},
 	}
@@ -198,14 +208,15 @@ func NewMemberResourceModel(ctx context.Context, data *MemberResourceModel, attr
 				}
 			}
 		} else if attributeName == "vlanId" {
-			member.VlanId = basetypes.NewFloat64Value(attributeValue.(float64))
+			member.VlanId = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 		} else if attributeName == "untagged" {
 			isUntagged = attributeValue.(bool)
 		}
 	}
 	if isUntagged {
-		member.VlanId = basetypes.NewFloat64Null()
+		member.VlanId = basetypes.NewInt64Null()
 	}
+	member.Untagged = basetypes.NewBoolValue(isUntagged)
 	tflog.Debug(ctx, fmt.Sprintf("MEMBER LHLOG2 '%v' & '%v' & '%v'", data, attributes, member))
 	return member
 }
@@ -235,18 +246,133 @@ func getMembersJsonPayload(ctx context.Context, data basetypes.SetValue) []map[s
 	data.ElementsAs(ctx, &members, false)
 	memberPayloads := make([]map[string]interface{}, 0)
 	for _, member := range members {
-		memberPayload := map[string]interface{}{
-			"port": map[string]string{
-				"portName": StripQuotes(member.PortName.String()),
-				"nodeId":   StripQuotes(member.NodeId.String()),
-			},
+		memberPayloads = append(memberPayloads, getMemberJsonPayload(member))
+	}
+	return memberPayloads
+}
+
+func getMemberJsonPayload(member MemberResourceModel) map[string]interface{} {
+	memberPayload := map[string]interface{}{
+		"port": map[string]string{
+			"portName": StripQuotes(member.PortName.String()),
+			"nodeId":   StripQuotes(member.NodeId.String()),
+		},
+	}
+	if !member.Untagged.ValueBool() && !member.VlanId.IsNull() && !member.VlanId.IsUnknown() {
+		memberPayload["vlanId"] = member.VlanId.ValueInt64()
+	} else {
+		memberPayload["untagged"] = true
+	}
+	return memberPayload
+}
+
+// getMemberKey returns a key identifying the member by its Node, Port and VLAN ID or untagged state.
+func getMemberKey(member MemberResourceModel) string {
+	vlanId := "untagged"
+	if !member.Untagged.ValueBool() && !member.VlanId.IsNull() && !member.VlanId.IsUnknown() {
+		vlanId = fmt.Sprintf("%d", member.VlanId.ValueInt64())
+	}
+	return fmt.Sprintf("%s/%s/%s", member.NodeId.ValueString(), member.PortName.ValueString(), vlanId)
+}
+
+func getMemberKeys(ctx context.Context, data basetypes.SetValue) []string {
+	keys := make([]string, 0)
+	if data.IsNull() || data.IsUnknown() {
+		return keys
+	}
+	members := []MemberResourceModel{}
+	data.ElementsAs(ctx, &members, false)
+	for _, member := range members {
+		keys = append(keys, getMemberKey(member))
+	}
+	return keys
+}
+
+// getDeclaredMembersSet returns the members of the set that are also found in the declared set.
+func getDeclaredMembersSet(ctx context.Context, data basetypes.SetValue, declared basetypes.SetValue) basetypes.SetValue {
+	declaredKeys := getMemberKeys(ctx, declared)
+	members := []MemberResourceModel{}
+	data.ElementsAs(ctx, &members, false)
+	declaredMembers := make([]MemberResourceModel, 0)
+	for _, member := range members {
+		if ContainsString(declaredKeys, getMemberKey(member)) {
+			declaredMembers = append(declaredMembers, member)
+		}
+	}
+	membersSet, _ := types.SetValueFrom(ctx, MemberResourceModelAttributeType(), declaredMembers)
+	return membersSet
+}
+
+// lockVniMembers locks the members of the VNI for a read-modify-write of its members and returns the unlock function.
+func lockVniMembers(client *client.Client, fabricId, vniId string) func() {
+	return client.LockObject(fmt.Sprintf("%s/vnis/%s", fabricId, vniId))
+}
+
+// preserveUndeclaredMembers adds the members of the VNI at objectPath that are neither found in the state nor in the
+// plan to the update payload, so the members managed outside of the VNI resource are not removed.
+func preserveUndeclaredMembers(ctx context.Context, diags *diag.Diagnostics, client *client.Client, objectPath string, jsonPayload *gabs.Container, stateMembers, planMembers basetypes.SetValue) {
+	if jsonPayload == nil {
+		return
+	}
+
+	requestData := DoRestRequest(ctx, diags, client, objectPath, "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return
+	}
+
+	attributes, ok := requestData.Data().(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	remoteMembers, _ := attributes["members"].([]interface{})
+	declaredKeys := append(getMemberKeys(ctx, stateMembers), getMemberKeys(ctx, planMembers)...)
+	members, _ := jsonPayload.Path("members").Data().([]interface{})
+	for _, remoteMember := range remoteMembers {
+		remoteMemberMap, ok := remoteMember.(map[string]interface{})
+		if !ok {
+			continue
 		}
-		if !member.VlanId.IsNull() && !member.VlanId.IsUnknown() {
-			memberPayload["vlanId"] = member.VlanId.ValueFloat64()
-		} else {
-			memberPayload["untagged"] = true
+		member := NewMemberResourceModel(ctx, getEmptyMemberResourceModel(), remoteMemberMap)
+		if !ContainsString(declaredKeys, getMemberKey(member)) {
+			tflog.Debug(ctx, fmt.Sprintf("Preserving undeclared member '%s' of '%s'", getMemberKey(member), objectPath))
+			members = append(members, getMemberJsonPayload(member))
 		}
-		memberPayloads = append(memberPayloads, memberPayload)
 	}
-	return memberPayloads
+	jsonPayload.Set(members, "members")
+}
+
+// getPlannedMembersUntaggedSet sets the untagged state of the planned members that do not configure it, based on the
+// presence of a VLAN ID, and reports the members configured with both a VLAN ID and an untagged state.
+func getPlannedMembersUntaggedSet(ctx context.Context, diags *diag.Diagnostics, data basetypes.SetValue) basetypes.SetValue {
+	members := []MemberResourceModel{}
+	diags.Append(data.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return data
+	}
+
+	for index, member := range members {
+		if member.VlanId.IsUnknown() {
+			continue
+		}
+		if member.Untagged.IsUnknown() {
+			members[index].Untagged = basetypes.NewBoolValue(member.VlanId.IsNull())
+		} else if member.Untagged.ValueBool() && !member.VlanId.IsNull() {
+			diags.AddAttributeError(
+				path.Root("members"),
+				"Invalid VNI Member",
+				fmt.Sprintf("The member on port '%s' of node '%s' cannot be configured with both the VLAN ID '%d' and untagged set to true.", member.PortName.ValueString(), member.NodeId.ValueString(), member.VlanId.ValueInt64()),
+			)
+		} else if !member.Untagged.IsNull() && !member.Untagged.ValueBool() && member.VlanId.IsNull() {
+			diags.AddAttributeError(
+				path.Root("members"),
+				"Invalid VNI Member",
+				fmt.Sprintf("The member on port '%s' of node '%s' must be configured with a VLAN ID when untagged is set to false.", member.PortName.ValueString(), member.NodeId.ValueString()),
+			)
+		}
+	}
+
+	membersSet, setDiags := types.SetValueFrom(ctx, MemberResourceModelAttributeType(), members)
+	diags.Append(setDiags...)
+	return membersSet
 }
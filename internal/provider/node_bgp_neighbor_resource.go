@@ -0,0 +1,936 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	customTypes "github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/provider/custom_types"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeBgpNeighborResource{}
+var _ resource.ResourceWithImportState = &NodeBgpNeighborResource{}
+var _ resource.ResourceWithModifyPlan = &NodeBgpNeighborResource{}
+
+// The key used to store in the private state whether the password of the BGP Neighbor has been set by the provider.
+const bgpNeighborPasswordPrivateKey = "password_configured"
+
+func NewNodeBgpNeighborResource() resource.Resource {
+	return &NodeBgpNeighborResource{}
+}
+
+// NodeBgpNeighborResource defines the resource implementation.
+type NodeBgpNeighborResource struct {
+	client *client.Client
+}
+
+// NodeBgpNeighborResourceModel describes the resource data model.
+type NodeBgpNeighborResourceModel struct {
+	Id                   types.String                      `tfsdk:"id"`
+	NodeId               types.String                      `tfsdk:"node_id"`
+	BgpNeighborId        types.String                      `tfsdk:"bgp_neighbor_id"`
+	Name                 types.String                      `tfsdk:"name"`
+	Description          types.String                      `tfsdk:"description"`
+	Enabled              types.Bool                        `tfsdk:"enabled"`
+	VrfId                customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	PeerAddress          types.String                      `tfsdk:"peer_address"`
+	RemoteAsn            types.Int64                       `tfsdk:"remote_asn"`
+	UpdateSource         types.String                      `tfsdk:"update_source"`
+	KeepaliveTimer       types.Int64                       `tfsdk:"keepalive_timer"`
+	HoldTimer            types.Int64                       `tfsdk:"hold_timer"`
+	Password             types.String                      `tfsdk:"password"`
+	PasswordVersion      types.Int64                       `tfsdk:"password_version"`
+	Bfd                  types.Bool                        `tfsdk:"bfd"`
+	AddressFamilies      types.Set                         `tfsdk:"address_families"`
+	Metadata             types.Object                      `tfsdk:"metadata"`
+	Labels               types.Set                         `tfsdk:"labels"`
+	Annotations          types.Set                         `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool                        `tfsdk:"ignore_external_labels"`
+}
+
+// NodeBgpNeighborAddressFamilyResourceModel describes an address family of a BGP Neighbor.
+type NodeBgpNeighborAddressFamilyResourceModel struct {
+	Type                  types.String `tfsdk:"type"`
+	MaxPrefixes           types.Int64  `tfsdk:"max_prefixes"`
+	MaxPrefixesAction     types.String `tfsdk:"max_prefixes_action"`
+	InboundRoutePolicyId  types.String `tfsdk:"inbound_route_policy_id"`
+	OutboundRoutePolicyId types.String `tfsdk:"outbound_route_policy_id"`
+	InboundPrefixListId   types.String `tfsdk:"inbound_prefix_list_id"`
+	OutboundPrefixListId  types.String `tfsdk:"outbound_prefix_list_id"`
+}
+
+// {
+// 	"type": "IPV4_UNICAST",
+// 	"maxPrefixes": 1000,
+// 	"maxPrefixesAction": "WARNING",
+// 	"inboundRoutePolicyId": "0b3f6a5e-7c1d-4e8f-9a2b-3c4d5e6f7a8b",
+// 	"outboundPrefixListId": "8a1d5c47-2d0e-4d2b-9a8f-0e7c3b2a1f60"
+// }
+
+func NodeBgpNeighborAddressFamilyResourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":                     types.StringType,
+			"max_prefixes":             types.Int64Type,
+			"max_prefixes_action":      types.StringType,
+			"inbound_route_policy_id":  types.StringType,
+			"outbound_route_policy_id": types.StringType,
+			"inbound_prefix_list_id":   types.StringType,
+			"outbound_prefix_list_id":  types.StringType,
+		},
+	}
+}
+
+func getEmptyNodeBgpNeighborResourceModel() *NodeBgpNeighborResourceModel {
+	return &NodeBgpNeighborResourceModel{
+		Id:                   basetypes.NewStringNull(),
+		NodeId:               basetypes.NewStringNull(),
+		BgpNeighborId:        basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolNull(),
+		VrfId:                customTypes.NewUuidFromIdStringNull(),
+		PeerAddress:          basetypes.NewStringNull(),
+		RemoteAsn:            basetypes.NewInt64Null(),
+		UpdateSource:         basetypes.NewStringNull(),
+		KeepaliveTimer:       basetypes.NewInt64Null(),
+		HoldTimer:            basetypes.NewInt64Null(),
+		Password:             basetypes.NewStringNull(),
+		PasswordVersion:      basetypes.NewInt64Null(),
+		Bfd:                  basetypes.NewBoolNull(),
+		AddressFamilies:      basetypes.NewSetNull(NodeBgpNeighborAddressFamilyResourceModelAttributeType()),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
+	}
+}
+
+func getNewNodeBgpNeighborResourceModelFromData(data *NodeBgpNeighborResourceModel) *NodeBgpNeighborResourceModel {
+	newNodeBgpNeighbor := getEmptyNodeBgpNeighborResourceModel()
+
+	if !data.Id.IsNull() && !data.Id.IsUnknown() {
+		newNodeBgpNeighbor.Id = data.Id
+	}
+
+	if !data.NodeId.IsNull() && !data.NodeId.IsUnknown() {
+		newNodeBgpNeighbor.NodeId = data.NodeId
+	}
+
+	if !data.BgpNeighborId.IsNull() && !data.BgpNeighborId.IsUnknown() {
+		newNodeBgpNeighbor.BgpNeighborId = data.BgpNeighborId
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		newNodeBgpNeighbor.Name = data.Name
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		newNodeBgpNeighbor.Description = data.Description
+	}
+
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		newNodeBgpNeighbor.Enabled = data.Enabled
+	}
+
+	if !data.VrfId.IsNull() && !data.VrfId.IsUnknown() {
+		newNodeBgpNeighbor.VrfId = data.VrfId
+	}
+
+	if !data.PeerAddress.IsNull() && !data.PeerAddress.IsUnknown() {
+		newNodeBgpNeighbor.PeerAddress = data.PeerAddress
+	}
+
+	if !data.RemoteAsn.IsNull() && !data.RemoteAsn.IsUnknown() {
+		newNodeBgpNeighbor.RemoteAsn = data.RemoteAsn
+	}
+
+	if !data.UpdateSource.IsNull() && !data.UpdateSource.IsUnknown() {
+		newNodeBgpNeighbor.UpdateSource = data.UpdateSource
+	}
+
+	if !data.KeepaliveTimer.IsNull() && !data.KeepaliveTimer.IsUnknown() {
+		newNodeBgpNeighbor.KeepaliveTimer = data.KeepaliveTimer
+	}
+
+	if !data.HoldTimer.IsNull() && !data.HoldTimer.IsUnknown() {
+		newNodeBgpNeighbor.HoldTimer = data.HoldTimer
+	}
+
+	// The write-only password is never copied as it must not be stored in the state
+	if !data.PasswordVersion.IsNull() && !data.PasswordVersion.IsUnknown() {
+		newNodeBgpNeighbor.PasswordVersion = data.PasswordVersion
+	}
+
+	if !data.Bfd.IsNull() && !data.Bfd.IsUnknown() {
+		newNodeBgpNeighbor.Bfd = data.Bfd
+	}
+
+	if !data.AddressFamilies.IsNull() && !data.AddressFamilies.IsUnknown() {
+		newNodeBgpNeighbor.AddressFamilies = data.AddressFamilies
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		newNodeBgpNeighbor.Metadata = data.Metadata
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		newNodeBgpNeighbor.Labels = data.Labels
+	}
+
+	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+		newNodeBgpNeighbor.Annotations = data.Annotations
+	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newNodeBgpNeighbor.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
+	return newNodeBgpNeighbor
+}
+
+type NodeBgpNeighborIdentifier struct {
+	Id types.String
+}
+
+func (r *NodeBgpNeighborResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *NodeBgpNeighborResourceModel
+		var password types.String
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A hold timer of 0 disables the keepalives, otherwise it must be greater than the keepalive timer
+		if !planData.KeepaliveTimer.IsNull() && !planData.KeepaliveTimer.IsUnknown() && !planData.HoldTimer.IsNull() && !planData.HoldTimer.IsUnknown() &&
+			planData.HoldTimer.ValueInt64() != 0 && planData.HoldTimer.ValueInt64() <= planData.KeepaliveTimer.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hold_timer"),
+				"Invalid BGP Timers",
+				fmt.Sprintf("The hold_timer (%d) must be greater than the keepalive_timer (%d), or set to 0 to disable the keepalives.", planData.HoldTimer.ValueInt64(), planData.KeepaliveTimer.ValueInt64()),
+			)
+		}
+
+		if !planData.AddressFamilies.IsNull() && !planData.AddressFamilies.IsUnknown() {
+			addressFamilies := []NodeBgpNeighborAddressFamilyResourceModel{}
+			resp.Diagnostics.Append(planData.AddressFamilies.ElementsAs(ctx, &addressFamilies, false)...)
+			addressFamilyTypes := map[string]bool{}
+			for _, addressFamily := range addressFamilies {
+				if addressFamily.Type.IsUnknown() {
+					continue
+				}
+				if addressFamilyTypes[addressFamily.Type.ValueString()] {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("address_families"),
+						"Duplicate BGP Address Family",
+						fmt.Sprintf("The address family '%s' can only be configured once for a BGP Neighbor.", addressFamily.Type.ValueString()),
+					)
+				}
+				addressFamilyTypes[addressFamily.Type.ValueString()] = true
+			}
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if stateData != nil {
+			// The metadata changes when the write-only password is set or removed, which ensures an update is planned
+			if isBgpNeighborPasswordRequired(ctx, req.Private, planData, stateData, password) || isBgpNeighborPasswordRemoved(ctx, req.Private, password) {
+				planData.Metadata = basetypes.NewObjectUnknown(MetadataResourceModelAttributeType())
+				resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+			}
+		}
+
+		if planData.NodeId.IsUnknown() || planData.PeerAddress.IsUnknown() || planData.UpdateSource.IsUnknown() {
+			return
+		}
+
+		// Query the Fabric only when the peer address, the VRF or the update source are planned to change
+		if stateData == nil || stateData.PeerAddress.ValueString() != planData.PeerAddress.ValueString() ||
+			stateData.VrfId.ValueString() != planData.VrfId.ValueString() || stateData.UpdateSource.ValueString() != planData.UpdateSource.ValueString() {
+			vrfId := ""
+			if !planData.VrfId.IsUnknown() {
+				vrfId = planData.VrfId.ValueString()[strings.LastIndex(planData.VrfId.ValueString(), "/")+1:]
+			}
+			checkBgpNeighborConsistency(ctx, &resp.Diagnostics, r.client, planData.NodeId.ValueString(), planData.BgpNeighborId.ValueString(), vrfId, planData.PeerAddress.ValueString(), planData.UpdateSource.ValueString())
+		}
+	}
+}
+
+func (r *NodeBgpNeighborResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_node_bgp_neighbor")
+	resp.TypeName = req.ProviderTypeName + "_node_bgp_neighbor"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_node_bgp_neighbor")
+}
+
+func (r *NodeBgpNeighborResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_node_bgp_neighbor")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Node BGP Neighbor resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a BGP Neighbor of a Node in a Fabric.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "`node_id` defines the unique identifier of a Node in a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bgp_neighbor_id": schema.StringAttribute{
+				MarkdownDescription: "`bgp_neighbor_id` defines the unique identifier of a BGP Neighbor of a Node.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the BGP Neighbor of the Node.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the BGP Neighbor of the Node.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "The enabled admin state of the BGP Neighbor of the Node.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vrf_id": schema.StringAttribute{
+				CustomType:          customTypes.UuidFromIdStringType{},
+				MarkdownDescription: "The `vrf_id` of a VRF in which the BGP Neighbor of the Node is configured.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+					CompareUuidWithIdForEquality(),
+				},
+			},
+			"peer_address": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 or IPv6 address of the BGP peer.",
+				Required:            true,
+				Validators: []validator.String{
+					IsIpAddress(),
+				},
+			},
+			"remote_asn": schema.Int64Attribute{
+				MarkdownDescription: "The Autonomous System Number (ASN) of the BGP peer.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
+				},
+			},
+			"update_source": schema.StringAttribute{
+				MarkdownDescription: "The name of the Loopback or Sub-Interface of the Node used as the source of the BGP session.",
+				Optional:            true,
+				Validators: []validator.String{
+					IsBgpUpdateSource(),
+				},
+			},
+			"keepalive_timer": schema.Int64Attribute{
+				MarkdownDescription: "The interval in seconds between the keepalive messages sent to the BGP peer.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+				},
+			},
+			"hold_timer": schema.Int64Attribute{
+				MarkdownDescription: "The time in seconds after which the BGP session is considered down when no message is received from the BGP peer.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(0),
+						int64validator.Between(3, 3600),
+					),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "A password used to authenticate the BGP session with TCP MD5. The password is write-only and never stored in the state. It is only sent when the BGP Neighbor is created, when `password_version` changes, or when the password is added to or removed from the configuration.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "A version of the `password` that triggers the rotation of the password when changed.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.Expressions{
+						path.MatchRoot("password"),
+					}...),
+				},
+			},
+			"bfd": schema.BoolAttribute{
+				MarkdownDescription: "Enable Bidirectional Forwarding Detection (BFD) for the BGP session.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"address_families": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of address families enabled for the BGP Neighbor.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the address family.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("IPV4_UNICAST", "IPV6_UNICAST"),
+							},
+						},
+						"max_prefixes": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of prefixes accepted from the BGP peer for the address family.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4294967295),
+							},
+						},
+						"max_prefixes_action": schema.StringAttribute{
+							MarkdownDescription: "The action taken when the BGP peer exceeds the maximum number of prefixes.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("WARNING", "SHUTDOWN", "RESTART"),
+								stringvalidator.AlsoRequires(path.Expressions{
+									path.MatchRelative().AtParent().AtName("max_prefixes"),
+								}...),
+							},
+						},
+						"inbound_route_policy_id": schema.StringAttribute{
+							MarkdownDescription: "The `route_policy_id` of a Route Policy applied to the routes received from the BGP peer.",
+							Optional:            true,
+						},
+						"outbound_route_policy_id": schema.StringAttribute{
+							MarkdownDescription: "The `route_policy_id` of a Route Policy applied to the routes advertised to the BGP peer.",
+							Optional:            true,
+						},
+						"inbound_prefix_list_id": schema.StringAttribute{
+							MarkdownDescription: "The `prefix_list_id` of a Prefix List filtering the routes received from the BGP peer.",
+							Optional:            true,
+						},
+						"outbound_prefix_list_id": schema.StringAttribute{
+							MarkdownDescription: "The `prefix_list_id` of a Prefix List filtering the routes advertised to the BGP peer.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_node_bgp_neighbor")
+}
+
+func (r *NodeBgpNeighborResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_node_bgp_neighbor")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_node_bgp_neighbor")
+}
+
+func (r *NodeBgpNeighborResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_node_bgp_neighbor")
+
+	var data *NodeBgpNeighborResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &data.Password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	passwordConfigured := !data.Password.IsNull()
+
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_node_bgp_neighbor with name '%s'", data.Name.ValueString()))
+
+	jsonPayload := getNodeBgpNeighborJsonPayload(ctx, &resp.Diagnostics, data, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors", data.NodeId.ValueString()), "POST", jsonPayload)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bgpNeighborContainer, err := container.ArrayElement(0, "bgpNeighbors")
+	if err != nil {
+		return
+	}
+
+	bgpNeighborId := StripQuotes(bgpNeighborContainer.Search("id").String())
+	if bgpNeighborId != "" {
+		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/bgpNeighbors/%s", data.NodeId.ValueString(), bgpNeighborId))
+		data.BgpNeighborId = basetypes.NewStringValue(bgpNeighborId)
+		getAndSetNodeBgpNeighborAttributes(ctx, &resp.Diagnostics, r.client, data)
+		setWriteOnlySecretPrivateState(ctx, &resp.Diagnostics, resp.Private, bgpNeighborPasswordPrivateKey, passwordConfigured)
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodeBgpNeighborResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_node_bgp_neighbor")
+	var data *NodeBgpNeighborResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+	checkAndSetNodeBgpNeighborIds(data)
+	getAndSetNodeBgpNeighborAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *NodeBgpNeighborResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodeBgpNeighborResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_node_bgp_neighbor")
+	var data *NodeBgpNeighborResourceModel
+	var stateData *NodeBgpNeighborResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+
+	// The write-only password is only sent when it has to be set again or cleared
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if isBgpNeighborPasswordRequired(ctx, req.Private, data, stateData, password) {
+		data.Password = password
+	} else if isBgpNeighborPasswordRemoved(ctx, req.Private, password) {
+		data.Password = basetypes.NewStringValue("")
+	}
+
+	jsonPayload := getNodeBgpNeighborJsonPayload(ctx, &resp.Diagnostics, data, "update")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors/%s", data.NodeId.ValueString(), data.BgpNeighborId.ValueString()), "hyperfabric_node_bgp_neighbor", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors/%s", data.NodeId.ValueString(), data.BgpNeighborId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors/%s", data.NodeId.ValueString(), data.BgpNeighborId.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetNodeBgpNeighborAttributes(ctx, &resp.Diagnostics, r.client, data)
+	setWriteOnlySecretPrivateState(ctx, &resp.Diagnostics, resp.Private, bgpNeighborPasswordPrivateKey, !password.IsNull())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodeBgpNeighborResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_node_bgp_neighbor")
+	var data *NodeBgpNeighborResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+	checkAndSetNodeBgpNeighborIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors/%s", data.NodeId.ValueString(), data.BgpNeighborId.ValueString()), "hyperfabric_node_bgp_neighbor", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors/%s", data.NodeId.ValueString(), data.BgpNeighborId.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_node_bgp_neighbor with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodeBgpNeighborResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_node_bgp_neighbor")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *NodeBgpNeighborResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_node_bgp_neighbor with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_node_bgp_neighbor with id")
+}
+
+func getAndSetNodeBgpNeighborAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *NodeBgpNeighborResourceModel) {
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/bgpNeighbors/%s", data.NodeId.ValueString(), data.BgpNeighborId.ValueString()), "GET", nil)
+	if diags.HasError() {
+		return
+	}
+
+	newNodeBgpNeighbor := *getNewNodeBgpNeighborResourceModelFromData(data)
+	node := getEmptyNodeResourceModel()
+	node.Id = newNodeBgpNeighbor.NodeId
+	checkAndSetNodeIds(node)
+
+	if requestData.Data() != nil {
+		// The BGP Neighbor does not use BFD unless reported otherwise
+		newNodeBgpNeighbor.Bfd = basetypes.NewBoolValue(false)
+		for attributeName, attributeValue := range requestData.Data().(map[string]interface{}) {
+			if attributeName == "id" && (data.BgpNeighborId.IsNull() || data.BgpNeighborId.IsUnknown() || data.BgpNeighborId.ValueString() == "" || data.BgpNeighborId.ValueString() != attributeValue.(string)) {
+				newNodeBgpNeighbor.BgpNeighborId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "fabricId" && (node.FabricId.IsNull() || node.FabricId.IsUnknown() || node.FabricId.ValueString() == "" || node.FabricId.ValueString() != attributeValue.(string)) {
+				node.FabricId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "nodeId" && (node.NodeId.IsNull() || node.NodeId.IsUnknown() || node.NodeId.ValueString() == "" || node.NodeId.ValueString() != attributeValue.(string)) {
+				node.NodeId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "name" {
+				newNodeBgpNeighbor.Name = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "description" {
+				newNodeBgpNeighbor.Description = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "enabled" {
+				newNodeBgpNeighbor.Enabled = basetypes.NewBoolValue(attributeValue.(bool))
+			} else if attributeName == "vrfId" {
+				newNodeBgpNeighbor.VrfId = customTypes.NewUuidFromIdStringValue(attributeValue.(string))
+			} else if attributeName == "peerAddress" {
+				newNodeBgpNeighbor.PeerAddress = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "remoteAsn" {
+				newNodeBgpNeighbor.RemoteAsn = basetypes.NewInt64Value(int64(attributeValue.(float64)))
+			} else if attributeName == "updateSource" {
+				// An empty update source is stored as null to match a configuration that omits it
+				if attributeValue.(string) != "" {
+					newNodeBgpNeighbor.UpdateSource = basetypes.NewStringValue(attributeValue.(string))
+				} else {
+					newNodeBgpNeighbor.UpdateSource = basetypes.NewStringNull()
+				}
+			} else if attributeName == "keepaliveTimer" {
+				newNodeBgpNeighbor.KeepaliveTimer = basetypes.NewInt64Value(int64(attributeValue.(float64)))
+			} else if attributeName == "holdTimer" {
+				newNodeBgpNeighbor.HoldTimer = basetypes.NewInt64Value(int64(attributeValue.(float64)))
+			} else if attributeName == "bfd" {
+				newNodeBgpNeighbor.Bfd = basetypes.NewBoolValue(attributeValue.(bool))
+			} else if attributeName == "addressFamilies" {
+				newNodeBgpNeighbor.AddressFamilies = NewNodeBgpNeighborAddressFamiliesSet(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "metadata" {
+				newNodeBgpNeighbor.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
+			} else if attributeName == "labels" {
+				newNodeBgpNeighbor.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
+			} else if attributeName == "annotations" {
+				newNodeBgpNeighbor.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
+			}
+		}
+		if node.FabricId.ValueString() != "" && node.NodeId.ValueString() != "" {
+			newNodeBgpNeighbor.NodeId = basetypes.NewStringValue(fmt.Sprintf("%s/nodes/%s", node.FabricId.ValueString(), node.NodeId.ValueString()))
+		}
+		newNodeBgpNeighbor.Id = basetypes.NewStringValue(fmt.Sprintf("%s/bgpNeighbors/%s", newNodeBgpNeighbor.NodeId.ValueString(), newNodeBgpNeighbor.BgpNeighborId.ValueString()))
+	} else {
+		newNodeBgpNeighbor.Id = basetypes.NewStringNull()
+	}
+	*data = newNodeBgpNeighbor
+}
+
+func NewNodeBgpNeighborAddressFamiliesSet(ctx context.Context, requestData []interface{}) basetypes.SetValue {
+	addressFamilies := make([]NodeBgpNeighborAddressFamilyResourceModel, 0)
+	for _, addressFamily := range requestData {
+		addressFamilyMap, ok := addressFamily.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		newAddressFamily := NodeBgpNeighborAddressFamilyResourceModel{
+			Type:                  basetypes.NewStringValue(getStringFromMap(addressFamilyMap, "type")),
+			MaxPrefixes:           basetypes.NewInt64Null(),
+			MaxPrefixesAction:     basetypes.NewStringNull(),
+			InboundRoutePolicyId:  basetypes.NewStringNull(),
+			OutboundRoutePolicyId: basetypes.NewStringNull(),
+			InboundPrefixListId:   basetypes.NewStringNull(),
+			OutboundPrefixListId:  basetypes.NewStringNull(),
+		}
+		if maxPrefixes, ok := addressFamilyMap["maxPrefixes"].(float64); ok && maxPrefixes > 0 {
+			newAddressFamily.MaxPrefixes = basetypes.NewInt64Value(int64(maxPrefixes))
+		}
+		if maxPrefixesAction := getStringFromMap(addressFamilyMap, "maxPrefixesAction"); maxPrefixesAction != "" {
+			newAddressFamily.MaxPrefixesAction = basetypes.NewStringValue(maxPrefixesAction)
+		}
+		if inboundRoutePolicyId := getStringFromMap(addressFamilyMap, "inboundRoutePolicyId"); inboundRoutePolicyId != "" {
+			newAddressFamily.InboundRoutePolicyId = basetypes.NewStringValue(inboundRoutePolicyId)
+		}
+		if outboundRoutePolicyId := getStringFromMap(addressFamilyMap, "outboundRoutePolicyId"); outboundRoutePolicyId != "" {
+			newAddressFamily.OutboundRoutePolicyId = basetypes.NewStringValue(outboundRoutePolicyId)
+		}
+		if inboundPrefixListId := getStringFromMap(addressFamilyMap, "inboundPrefixListId"); inboundPrefixListId != "" {
+			newAddressFamily.InboundPrefixListId = basetypes.NewStringValue(inboundPrefixListId)
+		}
+		if outboundPrefixListId := getStringFromMap(addressFamilyMap, "outboundPrefixListId"); outboundPrefixListId != "" {
+			newAddressFamily.OutboundPrefixListId = basetypes.NewStringValue(outboundPrefixListId)
+		}
+		addressFamilies = append(addressFamilies, newAddressFamily)
+	}
+	addressFamiliesSet, _ := types.SetValueFrom(ctx, NodeBgpNeighborAddressFamilyResourceModelAttributeType(), addressFamilies)
+	return addressFamiliesSet
+}
+
+func getNodeBgpNeighborAddressFamiliesJsonPayload(ctx context.Context, data basetypes.SetValue) []map[string]interface{} {
+	addressFamilies := []NodeBgpNeighborAddressFamilyResourceModel{}
+	data.ElementsAs(ctx, &addressFamilies, false)
+	addressFamilyPayloads := make([]map[string]interface{}, 0)
+	for _, addressFamily := range addressFamilies {
+		addressFamilyPayload := map[string]interface{}{
+			"type": addressFamily.Type.ValueString(),
+		}
+		if !addressFamily.MaxPrefixes.IsNull() && !addressFamily.MaxPrefixes.IsUnknown() {
+			addressFamilyPayload["maxPrefixes"] = addressFamily.MaxPrefixes.ValueInt64()
+		}
+		if !addressFamily.MaxPrefixesAction.IsNull() && !addressFamily.MaxPrefixesAction.IsUnknown() {
+			addressFamilyPayload["maxPrefixesAction"] = addressFamily.MaxPrefixesAction.ValueString()
+		}
+		if !addressFamily.InboundRoutePolicyId.IsNull() && !addressFamily.InboundRoutePolicyId.IsUnknown() {
+			addressFamilyPayload["inboundRoutePolicyId"] = addressFamily.InboundRoutePolicyId.ValueString()
+		}
+		if !addressFamily.OutboundRoutePolicyId.IsNull() && !addressFamily.OutboundRoutePolicyId.IsUnknown() {
+			addressFamilyPayload["outboundRoutePolicyId"] = addressFamily.OutboundRoutePolicyId.ValueString()
+		}
+		if !addressFamily.InboundPrefixListId.IsNull() && !addressFamily.InboundPrefixListId.IsUnknown() {
+			addressFamilyPayload["inboundPrefixListId"] = addressFamily.InboundPrefixListId.ValueString()
+		}
+		if !addressFamily.OutboundPrefixListId.IsNull() && !addressFamily.OutboundPrefixListId.IsUnknown() {
+			addressFamilyPayload["outboundPrefixListId"] = addressFamily.OutboundPrefixListId.ValueString()
+		}
+		addressFamilyPayloads = append(addressFamilyPayloads, addressFamilyPayload)
+	}
+	return addressFamilyPayloads
+}
+
+func getNodeBgpNeighborJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *NodeBgpNeighborResourceModel, action string) *gabs.Container {
+	payloadMap := map[string]interface{}{}
+	payloadList := []map[string]interface{}{}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		payloadMap["name"] = data.Name.ValueString()
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		payloadMap["description"] = data.Description.ValueString()
+	}
+
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		payloadMap["enabled"] = data.Enabled.ValueBool()
+	}
+
+	if !data.VrfId.IsNull() && !data.VrfId.IsUnknown() {
+		payloadMap["vrfId"] = data.VrfId.ValueString()
+	}
+
+	if !data.PeerAddress.IsNull() && !data.PeerAddress.IsUnknown() {
+		payloadMap["peerAddress"] = data.PeerAddress.ValueString()
+	}
+
+	if !data.RemoteAsn.IsNull() && !data.RemoteAsn.IsUnknown() {
+		payloadMap["remoteAsn"] = data.RemoteAsn.ValueInt64()
+	}
+
+	if !data.UpdateSource.IsNull() && !data.UpdateSource.IsUnknown() {
+		payloadMap["updateSource"] = data.UpdateSource.ValueString()
+	} else {
+		payloadMap["updateSource"] = ""
+	}
+
+	if !data.KeepaliveTimer.IsNull() && !data.KeepaliveTimer.IsUnknown() {
+		payloadMap["keepaliveTimer"] = data.KeepaliveTimer.ValueInt64()
+	}
+
+	if !data.HoldTimer.IsNull() && !data.HoldTimer.IsUnknown() {
+		payloadMap["holdTimer"] = data.HoldTimer.ValueInt64()
+	}
+
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
+		payloadMap["password"] = data.Password.ValueString()
+		payloadMap["setPassword"] = true
+	} else {
+		payloadMap["setPassword"] = false
+	}
+
+	if !data.Bfd.IsNull() && !data.Bfd.IsUnknown() {
+		payloadMap["bfd"] = data.Bfd.ValueBool()
+	}
+
+	if !data.AddressFamilies.IsNull() && !data.AddressFamilies.IsUnknown() {
+		payloadMap["addressFamilies"] = getNodeBgpNeighborAddressFamiliesJsonPayload(ctx, data.AddressFamilies)
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
+	}
+
+	if isAnnotationsPayloadRequired(data.Annotations) {
+		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
+	}
+
+	var payload map[string]interface{}
+	if action == "create" {
+		payloadList = append(payloadList, payloadMap)
+		payload = map[string]interface{}{"bgpNeighbors": payloadList}
+	} else {
+		payload = payloadMap
+	}
+
+	marshalPayload, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddError(
+			"Marshalling of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+
+	jsonPayload, err := gabs.ParseJSON(marshalPayload)
+	if err != nil {
+		diags.AddError(
+			"Construction of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+	return jsonPayload
+}
+
+func checkAndSetNodeBgpNeighborIds(data *NodeBgpNeighborResourceModel) {
+	if strings.Contains(data.Id.ValueString(), "/bgpNeighbors/") {
+		if data.NodeId.IsNull() || data.NodeId.IsUnknown() || data.NodeId.ValueString() == "" ||
+			data.BgpNeighborId.IsNull() || data.BgpNeighborId.IsUnknown() || data.BgpNeighborId.ValueString() == "" {
+			splitId := strings.Split(data.Id.ValueString(), "/bgpNeighbors/")
+			data.NodeId = basetypes.NewStringValue(splitId[0])
+			data.BgpNeighborId = basetypes.NewStringValue(splitId[1])
+		}
+	}
+}
+
+// isBgpNeighborPasswordConfigured returns true when the private state records that the password of the BGP Neighbor
+// was last set by the provider.
+func isBgpNeighborPasswordConfigured(ctx context.Context, private privateStateGetter) bool {
+	var passwordConfigured bool
+	return getWriteOnlySecretPrivateState(ctx, private, bgpNeighborPasswordPrivateKey, &passwordConfigured) && passwordConfigured
+}
+
+// isBgpNeighborPasswordRequired returns true when the configured write-only password has to be sent to the Fabric.
+func isBgpNeighborPasswordRequired(ctx context.Context, private privateStateGetter, planData, stateData *NodeBgpNeighborResourceModel, password types.String) bool {
+	if stateData == nil {
+		return !password.IsNull()
+	}
+	return isWriteOnlySecretRequired(password, planData.PasswordVersion, stateData.PasswordVersion, !isBgpNeighborPasswordConfigured(ctx, private))
+}
+
+// isBgpNeighborPasswordRemoved returns true when the password set by the provider has been removed from the configuration.
+func isBgpNeighborPasswordRemoved(ctx context.Context, private privateStateGetter, password types.String) bool {
+	return password.IsNull() && isBgpNeighborPasswordConfigured(ctx, private)
+}
@@ -0,0 +1,271 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeBgpNeighborResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that an update source which is neither a Loopback nor a Sub-Interface is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Validate that an update source which is neither a Loopback nor a Sub-Interface is rejected during plan.")
+				},
+				Config:      testNodeBgpNeighborResourceHclConfig(fabricName, "invalid_update_source"),
+				ExpectError: regexp.MustCompile(`Invalid BGP Update Source`),
+			},
+			// Validate that a hold timer lower than the keepalive timer is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Validate that a hold timer lower than the keepalive timer is rejected during plan.")
+				},
+				Config:      testNodeBgpNeighborResourceHclConfig(fabricName, "invalid_timers"),
+				ExpectError: regexp.MustCompile(`Invalid BGP Timers`),
+			},
+			// Validate that an address family configured twice is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Validate that an address family configured twice is rejected during plan.")
+				},
+				Config:      testNodeBgpNeighborResourceHclConfig(fabricName, "duplicate_address_family"),
+				ExpectError: regexp.MustCompile(`Duplicate BGP Address Family`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testNodeBgpNeighborResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hyperfabric_node_bgp_neighbor.test", "node_id", "hyperfabric_node.test", "id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "name", "border-router"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "peer_address", "10.4.0.2"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "remote_asn", "65001"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "bfd", "false"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_bgp_neighbor.test", "enabled"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_bgp_neighbor.test", "keepalive_timer"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_bgp_neighbor.test", "hold_timer"),
+					resource.TestCheckNoResourceAttr("hyperfabric_node_bgp_neighbor.test", "update_source"),
+					resource.TestCheckNoResourceAttr("hyperfabric_node_bgp_neighbor.test", "password"),
+				),
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:      "hyperfabric_node_bgp_neighbor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Update with all config and verify provided values.")
+				},
+				Config:             testNodeBgpNeighborResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "name", "border-router"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "description", "eBGP session to the border router"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_bgp_neighbor.test", "vrf_id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "peer_address", "10.4.0.2"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "remote_asn", "65001"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "update_source", "Loopback11"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "keepalive_timer", "10"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "hold_timer", "30"),
+					resource.TestCheckNoResourceAttr("hyperfabric_node_bgp_neighbor.test", "password"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "password_version", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "bfd", "true"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "address_families.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "labels.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "annotations.#", "2"),
+				),
+			},
+			// Run Plan Only with all config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Run Plan Only with all config and check that plan is empty.")
+				},
+				Config:             testNodeBgpNeighborResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Rotate the password and verify that only the password version changed.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Rotate the password and verify that only the password version changed.")
+				},
+				Config:             testNodeBgpNeighborResourceHclConfig(fabricName, "rotate_password"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hyperfabric_node_bgp_neighbor.test", "password"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "password_version", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_node_bgp_neighbor.test", "update_source", "Loopback11"),
+				),
+			},
+			// Read the status of the BGP session with the data source.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node BGP Neighbor - Read the status of the BGP session with the data source.")
+				},
+				Config:             testNodeBgpNeighborResourceHclConfig(fabricName, "status"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.hyperfabric_node_bgp_neighbor_status.test", "id", "hyperfabric_node_bgp_neighbor.test", "id"),
+					resource.TestCheckResourceAttr("data.hyperfabric_node_bgp_neighbor_status.test", "peer_address", "10.4.0.2"),
+					resource.TestCheckResourceAttr("data.hyperfabric_node_bgp_neighbor_status.test", "remote_asn", "65001"),
+					resource.TestCheckResourceAttrSet("data.hyperfabric_node_bgp_neighbor_status.test", "established"),
+				),
+			},
+		},
+	})
+}
+
+func testNodeBgpNeighborResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_vrf" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vrf1"
+}
+
+resource "hyperfabric_node_loopback" "test" {
+	node_id      = hyperfabric_node.test.id
+	name         = "Loopback11"
+	ipv4_address = "10.1.0.2"
+	vrf_id       = hyperfabric_vrf.test.vrf_id
+}
+`, fabricName)
+
+	full := `
+resource "hyperfabric_node_bgp_neighbor" "test" {
+	node_id          = hyperfabric_node.test.id
+	name             = "border-router"
+	description      = "eBGP session to the border router"
+	enabled          = true
+	vrf_id           = hyperfabric_vrf.test.vrf_id
+	peer_address     = "10.4.0.2"
+	remote_asn       = 65001
+	update_source    = hyperfabric_node_loopback.test.name
+	keepalive_timer  = 10
+	hold_timer       = 30
+	password         = "%[1]s"
+	password_version = %[2]d
+	bfd              = true
+	address_families = [
+		{
+			type                = "IPV4_UNICAST"
+			max_prefixes        = 1000
+			max_prefixes_action = "WARNING"
+		},
+		{
+			type = "IPV6_UNICAST"
+		}
+	]
+	labels = [
+		"sj01-1-101-AAA01",
+		"blue"
+	]
+	annotations = [
+		{
+			name  = "color"
+			value = "blue"
+		},
+		{
+			data_type = "UINT32"
+			name      = "rack"
+			value     = "1"
+		}
+	]
+}
+`
+
+	if configType == "invalid_update_source" {
+		return base + `
+resource "hyperfabric_node_bgp_neighbor" "test" {
+	node_id       = hyperfabric_node.test.id
+	name          = "border-router"
+	peer_address  = "10.4.0.2"
+	remote_asn    = 65001
+	update_source = "Ethernet1_10"
+}
+`
+	} else if configType == "invalid_timers" {
+		return base + `
+resource "hyperfabric_node_bgp_neighbor" "test" {
+	node_id         = hyperfabric_node.test.id
+	name            = "border-router"
+	peer_address    = "10.4.0.2"
+	remote_asn      = 65001
+	keepalive_timer = 60
+	hold_timer      = 30
+}
+`
+	} else if configType == "duplicate_address_family" {
+		return base + `
+resource "hyperfabric_node_bgp_neighbor" "test" {
+	node_id      = hyperfabric_node.test.id
+	name         = "border-router"
+	peer_address = "10.4.0.2"
+	remote_asn   = 65001
+	address_families = [
+		{
+			type = "IPV4_UNICAST"
+		},
+		{
+			type         = "IPV4_UNICAST"
+			max_prefixes = 1000
+		}
+	]
+}
+`
+	} else if configType == "full" {
+		return base + fmt.Sprintf(full, "Secret123", 1)
+	} else if configType == "rotate_password" {
+		return base + fmt.Sprintf(full, "Secret456", 2)
+	} else if configType == "status" {
+		return base + fmt.Sprintf(full, "Secret456", 2) + `
+data "hyperfabric_node_bgp_neighbor_status" "test" {
+	node_id = hyperfabric_node_bgp_neighbor.test.node_id
+	name    = hyperfabric_node_bgp_neighbor.test.name
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_node_bgp_neighbor" "test" {
+	node_id      = hyperfabric_node.test.id
+	name         = "border-router"
+	peer_address = "10.4.0.2"
+	remote_asn   = 65001
+}
+`
+	}
+}
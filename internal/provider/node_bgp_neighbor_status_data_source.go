@@ -0,0 +1,277 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeBgpNeighborStatusDataSource{}
+
+func NewNodeBgpNeighborStatusDataSource() datasource.DataSource {
+	return &NodeBgpNeighborStatusDataSource{}
+}
+
+// NodeBgpNeighborStatusDataSource defines the data source implementation.
+type NodeBgpNeighborStatusDataSource struct {
+	client *client.Client
+}
+
+// NodeBgpNeighborStatusDataSourceModel describes the data source data model.
+type NodeBgpNeighborStatusDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	NodeId          types.String `tfsdk:"node_id"`
+	BgpNeighborId   types.String `tfsdk:"bgp_neighbor_id"`
+	Name            types.String `tfsdk:"name"`
+	PeerAddress     types.String `tfsdk:"peer_address"`
+	RemoteAsn       types.Int64  `tfsdk:"remote_asn"`
+	SessionState    types.String `tfsdk:"session_state"`
+	Established     types.Bool   `tfsdk:"established"`
+	LastStateChange types.String `tfsdk:"last_state_change"`
+	LastError       types.String `tfsdk:"last_error"`
+	BfdState        types.String `tfsdk:"bfd_state"`
+	AddressFamilies types.Set    `tfsdk:"address_families"`
+}
+
+// NodeBgpNeighborStatusAddressFamilyDataSourceModel describes the prefix counters of an address family of a BGP session.
+type NodeBgpNeighborStatusAddressFamilyDataSourceModel struct {
+	Type             types.String `tfsdk:"type"`
+	PrefixesReceived types.Int64  `tfsdk:"prefixes_received"`
+	PrefixesAccepted types.Int64  `tfsdk:"prefixes_accepted"`
+	PrefixesSent     types.Int64  `tfsdk:"prefixes_sent"`
+}
+
+// {
+// 	"type": "IPV4_UNICAST",
+// 	"prefixesReceived": 10,
+// 	"prefixesAccepted": 8,
+// 	"prefixesSent": 4
+// }
+
+func NodeBgpNeighborStatusAddressFamilyDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":              types.StringType,
+			"prefixes_received": types.Int64Type,
+			"prefixes_accepted": types.Int64Type,
+			"prefixes_sent":     types.Int64Type,
+		},
+	}
+}
+
+func (d *NodeBgpNeighborStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_node_bgp_neighbor_status")
+	resp.TypeName = req.ProviderTypeName + "_node_bgp_neighbor_status"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_node_bgp_neighbor_status")
+}
+
+func (d *NodeBgpNeighborStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_node_bgp_neighbor_status")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Node BGP Neighbor Status data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a BGP Neighbor of a Node in a Fabric.",
+				Computed:            true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "`node_id` defines the unique identifier of a Node in a Fabric.",
+				Required:            true,
+			},
+			"bgp_neighbor_id": schema.StringAttribute{
+				MarkdownDescription: "`bgp_neighbor_id` defines the unique identifier of a BGP Neighbor of a Node.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the BGP Neighbor of the Node.",
+				Required:            true,
+			},
+			"peer_address": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 or IPv6 address of the BGP peer.",
+				Computed:            true,
+			},
+			"remote_asn": schema.Int64Attribute{
+				MarkdownDescription: "The Autonomous System Number (ASN) of the BGP peer.",
+				Computed:            true,
+			},
+			"session_state": schema.StringAttribute{
+				MarkdownDescription: "The state of the BGP session (i.e. `IDLE`, `CONNECT`, `ACTIVE`, `OPEN_SENT`, `OPEN_CONFIRM` or `ESTABLISHED`). Null when the BGP Neighbor has not been deployed on a Device yet.",
+				Computed:            true,
+			},
+			"established": schema.BoolAttribute{
+				MarkdownDescription: "Whether the BGP session is established.",
+				Computed:            true,
+			},
+			"last_state_change": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the last state change of the BGP session in RFC3339 format.",
+				Computed:            true,
+			},
+			"last_error": schema.StringAttribute{
+				MarkdownDescription: "The last error reported for the BGP session.",
+				Computed:            true,
+			},
+			"bfd_state": schema.StringAttribute{
+				MarkdownDescription: "The state of the BFD session with the BGP peer when BFD is enabled.",
+				Computed:            true,
+			},
+			"address_families": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of prefix counters per address family negotiated for the BGP session.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the address family.",
+							Computed:            true,
+						},
+						"prefixes_received": schema.Int64Attribute{
+							MarkdownDescription: "The number of prefixes received from the BGP peer.",
+							Computed:            true,
+						},
+						"prefixes_accepted": schema.Int64Attribute{
+							MarkdownDescription: "The number of prefixes received from the BGP peer and accepted.",
+							Computed:            true,
+						},
+						"prefixes_sent": schema.Int64Attribute{
+							MarkdownDescription: "The number of prefixes advertised to the BGP peer.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_bgp_neighbor_status")
+}
+
+func (d *NodeBgpNeighborStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_node_bgp_neighbor_status")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_node_bgp_neighbor_status")
+}
+
+func (d *NodeBgpNeighborStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_node_bgp_neighbor_status")
+	var data *NodeBgpNeighborStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_node_bgp_neighbor_status with name '%s'", data.Name.ValueString()))
+
+	// The BGP Neighbor is looked up by name to retrieve its id and peer before its status
+	bgpNeighbor := getEmptyNodeBgpNeighborResourceModel()
+	bgpNeighbor.NodeId = data.NodeId
+	bgpNeighbor.BgpNeighborId = data.Name
+	getAndSetNodeBgpNeighborAttributes(ctx, &resp.Diagnostics, d.client, bgpNeighbor)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if bgpNeighbor.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to read hyperfabric_node_bgp_neighbor_status data source",
+			fmt.Sprintf("The BGP Neighbor with name '%s' has not been found on the Node '%s'", data.Name.ValueString(), data.NodeId.ValueString()),
+		)
+		return
+	}
+
+	data.Id = bgpNeighbor.Id
+	data.NodeId = bgpNeighbor.NodeId
+	data.BgpNeighborId = bgpNeighbor.BgpNeighborId
+	data.Name = bgpNeighbor.Name
+	data.PeerAddress = bgpNeighbor.PeerAddress
+	data.RemoteAsn = bgpNeighbor.RemoteAsn
+	getAndSetNodeBgpNeighborStatusAttributes(ctx, &resp.Diagnostics, d.client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_node_bgp_neighbor_status with id '%s'", data.Id.ValueString()))
+}
+
+func getAndSetNodeBgpNeighborStatusAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *NodeBgpNeighborStatusDataSourceModel) {
+	data.SessionState = basetypes.NewStringNull()
+	data.Established = basetypes.NewBoolValue(false)
+	data.LastStateChange = basetypes.NewStringNull()
+	data.LastError = basetypes.NewStringNull()
+	data.BfdState = basetypes.NewStringNull()
+	data.AddressFamilies = basetypes.NewSetValueMust(NodeBgpNeighborStatusAddressFamilyDataSourceModelAttributeType(), []attr.Value{})
+
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/status", data.Id.ValueString()), "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return
+	}
+
+	attributes, ok := requestData.Data().(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if sessionState := getStringFromMap(attributes, "sessionState"); sessionState != "" {
+		data.SessionState = basetypes.NewStringValue(sessionState)
+		data.Established = basetypes.NewBoolValue(sessionState == "ESTABLISHED")
+	}
+	if lastStateChange := getStringFromMap(attributes, "lastStateChange"); lastStateChange != "" {
+		data.LastStateChange = basetypes.NewStringValue(lastStateChange)
+	}
+	if lastError := getStringFromMap(attributes, "lastError"); lastError != "" {
+		data.LastError = basetypes.NewStringValue(lastError)
+	}
+	if bfdState := getStringFromMap(attributes, "bfdState"); bfdState != "" {
+		data.BfdState = basetypes.NewStringValue(bfdState)
+	}
+
+	addressFamilies := make([]NodeBgpNeighborStatusAddressFamilyDataSourceModel, 0)
+	if addressFamilyList, ok := attributes["addressFamilies"].([]interface{}); ok {
+		for _, addressFamily := range addressFamilyList {
+			addressFamilyMap, ok := addressFamily.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			addressFamilies = append(addressFamilies, NodeBgpNeighborStatusAddressFamilyDataSourceModel{
+				Type:             basetypes.NewStringValue(getStringFromMap(addressFamilyMap, "type")),
+				PrefixesReceived: basetypes.NewInt64Value(getInt64FromMap(addressFamilyMap, "prefixesReceived")),
+				PrefixesAccepted: basetypes.NewInt64Value(getInt64FromMap(addressFamilyMap, "prefixesAccepted")),
+				PrefixesSent:     basetypes.NewInt64Value(getInt64FromMap(addressFamilyMap, "prefixesSent")),
+			})
+		}
+	}
+	addressFamiliesSet, setDiags := types.SetValueFrom(ctx, NodeBgpNeighborStatusAddressFamilyDataSourceModelAttributeType(), addressFamilies)
+	diags.Append(setDiags...)
+	data.AddressFamilies = addressFamiliesSet
+}
@@ -75,9 +75,10 @@ func (d *NodeBreakoutDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "The type of pluggable used for the Breakout.",
 				Computed:            true,
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_breakout")
@@ -14,6 +14,7 @@ import (
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -42,36 +44,38 @@ type NodeBreakoutResource struct {
 
 // NodeBreakoutResourceModel describes the resource data model.
 type NodeBreakoutResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	BreakoutId  types.String `tfsdk:"breakout_id"`
-	NodeId      types.String `tfsdk:"node_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	Breakouts   types.Set    `tfsdk:"breakouts"`
-	Ports       types.Set    `tfsdk:"ports"`
-	Mode        types.String `tfsdk:"mode"`
-	Pluggable   types.String `tfsdk:"pluggable"`
-	Metadata    types.Object `tfsdk:"metadata"`
-	Labels      types.Set    `tfsdk:"labels"`
-	Annotations types.Set    `tfsdk:"annotations"`
+	Id                   types.String `tfsdk:"id"`
+	BreakoutId           types.String `tfsdk:"breakout_id"`
+	NodeId               types.String `tfsdk:"node_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Breakouts            types.Set    `tfsdk:"breakouts"`
+	Ports                types.Set    `tfsdk:"ports"`
+	Mode                 types.String `tfsdk:"mode"`
+	Pluggable            types.String `tfsdk:"pluggable"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyNodeBreakoutResourceModel() *NodeBreakoutResourceModel {
 	return &NodeBreakoutResourceModel{
-		Id:          basetypes.NewStringNull(),
-		BreakoutId:  basetypes.NewStringNull(),
-		NodeId:      basetypes.NewStringNull(),
-		Name:        basetypes.NewStringNull(),
-		Description: basetypes.NewStringNull(),
-		Enabled:     basetypes.NewBoolValue(false),
-		Breakouts:   basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Ports:       basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Mode:        basetypes.NewStringNull(),
-		Pluggable:   basetypes.NewStringNull(),
-		Metadata:    basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Id:                   basetypes.NewStringNull(),
+		BreakoutId:           basetypes.NewStringNull(),
+		NodeId:               basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolValue(false),
+		Breakouts:            basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Ports:                basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Mode:                 basetypes.NewStringNull(),
+		Pluggable:            basetypes.NewStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -130,6 +134,10 @@ func getNewNodeBreakoutResourceModelFromData(data *NodeBreakoutResourceModel) *N
 		newNodeBreakout.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newNodeBreakout.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newNodeBreakout
 }
 
@@ -215,9 +223,10 @@ func (r *NodeBreakoutResource) Schema(ctx context.Context, req resource.SchemaRe
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_node_breakout")
@@ -246,6 +255,9 @@ func getBreakoutPortsSchemaAttribute() schema.SetAttribute {
 		PlanModifiers: []planmodifier.Set{
 			setplanmodifier.UseStateForUnknown(),
 		},
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsPortName()),
+		},
 		ElementType: types.StringType,
 	}
 }
@@ -269,6 +281,14 @@ func (r *NodeBreakoutResource) ModifyPlan(ctx context.Context, req resource.Modi
 			return
 		}
 
+		// Validate the ports against the model of the Node only when the ports are planned to change
+		if !planData.Ports.IsUnknown() && (stateData == nil || !stateData.Ports.Equal(planData.Ports)) {
+			checkPortNamesForNodeModel(ctx, &resp.Diagnostics, r.client, planData.NodeId, path.Root("ports"), getSetStringJsonPayload(ctx, planData.Ports), true)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		if stateData != nil {
 			// Set read-only fields in planData from stateData
 			planData.Breakouts = stateData.Breakouts
@@ -393,6 +413,16 @@ func (r *NodeBreakoutResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/breakouts/%s", data.NodeId.ValueString(), data.BreakoutId.ValueString()), "hyperfabric_node_breakout", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/breakouts/%s", data.NodeId.ValueString(), data.BreakoutId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/breakouts/%s", data.NodeId.ValueString(), data.BreakoutId.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -419,6 +449,11 @@ func (r *NodeBreakoutResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node_breakout with id '%s'", data.Id.ValueString()))
 	checkAndSetNodeBreakoutIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/breakouts/%s", data.NodeId.ValueString(), data.BreakoutId.ValueString()), "hyperfabric_node_breakout", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/breakouts/%s", data.NodeId.ValueString(), data.BreakoutId.ValueString()), "DELETE", nil)
 	if resp.Diagnostics.HasError() {
 		return
@@ -476,9 +511,9 @@ func getAndSetNodeBreakoutAttributes(ctx context.Context, diags *diag.Diagnostic
 			} else if attributeName == "metadata" {
 				newNodeBreakout.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newNodeBreakout.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newNodeBreakout.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newNodeBreakout.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newNodeBreakout.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
@@ -517,7 +552,7 @@ func getNodeBreakoutJsonPayload(ctx context.Context, diags *diag.Diagnostics, da
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
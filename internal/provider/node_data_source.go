@@ -85,10 +85,11 @@ func (d *NodeDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "The position of the Node in the Fabric.",
 				Computed:            true,
 			},
-			"roles":       getRolesDataSourceSchemaAttribute(),
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"roles":                  getRolesDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node")
@@ -77,9 +77,10 @@ func (d *NodeLoopbackDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "The `vrf_id` of a VRF to associate with the Loopback of the Node. Required when the Loopback roles include `ROUTED_PORT`.",
 				Computed:            true,
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_loopback")
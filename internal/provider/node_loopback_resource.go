@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -47,12 +48,13 @@ type NodeLoopbackResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	// Enabled     types.Bool   `tfsdk:"enabled"`
-	Ipv4Address types.String                      `tfsdk:"ipv4_address"`
-	Ipv6Address types.String                      `tfsdk:"ipv6_address"`
-	VrfId       customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
-	Metadata    types.Object                      `tfsdk:"metadata"`
-	Labels      types.Set                         `tfsdk:"labels"`
-	Annotations types.Set                         `tfsdk:"annotations"`
+	Ipv4Address          types.String                      `tfsdk:"ipv4_address"`
+	Ipv6Address          types.String                      `tfsdk:"ipv6_address"`
+	VrfId                customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	Metadata             types.Object                      `tfsdk:"metadata"`
+	Labels               types.Set                         `tfsdk:"labels"`
+	Annotations          types.Set                         `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool                        `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyNodeLoopbackResourceModel() *NodeLoopbackResourceModel {
@@ -63,12 +65,13 @@ func getEmptyNodeLoopbackResourceModel() *NodeLoopbackResourceModel {
 		Name:        basetypes.NewStringNull(),
 		Description: basetypes.NewStringNull(),
 		// Enabled:     basetypes.NewBoolValue(false),
-		Ipv4Address: basetypes.NewStringNull(),
-		Ipv6Address: basetypes.NewStringNull(),
-		VrfId:       customTypes.NewUuidFromIdStringNull(),
-		Metadata:    basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Ipv4Address:          basetypes.NewStringNull(),
+		Ipv6Address:          basetypes.NewStringNull(),
+		VrfId:                customTypes.NewUuidFromIdStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -123,6 +126,10 @@ func getNewNodeLoopbackResourceModelFromData(data *NodeLoopbackResourceModel) *N
 		newNodeLoopback.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newNodeLoopback.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newNodeLoopback
 }
 
@@ -130,6 +137,33 @@ type NodeLoopbackIdentifier struct {
 	Id types.String
 }
 
+func (r *NodeLoopbackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *NodeLoopbackResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		if resp.Diagnostics.HasError() || planData.NodeId.IsUnknown() {
+			return
+		}
+
+		// Query the Fabric for duplicate addresses only when the addresses are planned to change
+		addresses := map[string]string{}
+		if !planData.Ipv4Address.IsUnknown() && (stateData == nil || stateData.Ipv4Address.ValueString() != planData.Ipv4Address.ValueString()) {
+			addresses["ipv4_address"] = planData.Ipv4Address.ValueString()
+		}
+		if !planData.Ipv6Address.IsUnknown() && (stateData == nil || stateData.Ipv6Address.ValueString() != planData.Ipv6Address.ValueString()) {
+			addresses["ipv6_address"] = planData.Ipv6Address.ValueString()
+		}
+
+		vrfId := ""
+		if !planData.VrfId.IsUnknown() {
+			vrfId = planData.VrfId.ValueString()[strings.LastIndex(planData.VrfId.ValueString(), "/")+1:]
+		}
+		checkLoopbackAddressConsistency(ctx, &resp.Diagnostics, r.client, planData.NodeId.ValueString(), planData.LoopbackId.ValueString(), vrfId, addresses)
+	}
+}
+
 func (r *NodeLoopbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_node_loopback")
 	resp.TypeName = req.ProviderTypeName + "_node_loopback"
@@ -197,6 +231,9 @@ func (r *NodeLoopbackResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
+				Validators: []validator.String{
+					IsIpv4Address(),
+				},
 			},
 			"ipv6_address": schema.StringAttribute{
 				MarkdownDescription: "The IPv6 address configured on the Loopback of the Node.",
@@ -206,6 +243,9 @@ func (r *NodeLoopbackResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
+				Validators: []validator.String{
+					IsIpv6Address(),
+				},
 			},
 			"vrf_id": schema.StringAttribute{
 				CustomType:          customTypes.UuidFromIdStringType{},
@@ -218,9 +258,10 @@ func (r *NodeLoopbackResource) Schema(ctx context.Context, req resource.SchemaRe
 					CompareUuidWithIdForEquality(),
 				},
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_node_loopback")
@@ -267,6 +308,7 @@ func (r *NodeLoopbackResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/loopbacks", data.NodeId.ValueString()), "POST", jsonPayload)
+	clearFabricLoopbacksCache(r.client, data.NodeId.ValueString())
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -336,7 +378,18 @@ func (r *NodeLoopbackResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/loopbacks/%s", data.NodeId.ValueString(), data.LoopbackId.ValueString()), "hyperfabric_node_loopback", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/loopbacks/%s", data.NodeId.ValueString(), data.LoopbackId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/loopbacks/%s", data.NodeId.ValueString(), data.LoopbackId.ValueString()), "PUT", jsonPayload)
+	clearFabricLoopbacksCache(r.client, data.NodeId.ValueString())
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -362,7 +415,13 @@ func (r *NodeLoopbackResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node_loopback with id '%s'", data.Id.ValueString()))
 	checkAndSetNodeLoopbackIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/loopbacks/%s", data.NodeId.ValueString(), data.LoopbackId.ValueString()), "hyperfabric_node_loopback", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/loopbacks/%s", data.NodeId.ValueString(), data.LoopbackId.ValueString()), "DELETE", nil)
+	clearFabricLoopbacksCache(r.client, data.NodeId.ValueString())
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -415,9 +474,9 @@ func getAndSetNodeLoopbackAttributes(ctx context.Context, diags *diag.Diagnostic
 			} else if attributeName == "metadata" {
 				newNodeLoopback.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newNodeLoopback.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newNodeLoopback.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newNodeLoopback.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newNodeLoopback.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
@@ -459,7 +518,7 @@ func getNodeLoopbackJsonPayload(ctx context.Context, diags *diag.Diagnostics, da
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -29,6 +30,34 @@ type NodeManagementPortDataSource struct {
 	client *client.Client
 }
 
+// NodeManagementPortDataSourceModel describes the data source data model, which excludes the proxy_password_version
+// of the resource configuration.
+type NodeManagementPortDataSourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	NodeId               types.String `tfsdk:"node_id"`
+	NodeManagementPortId types.String `tfsdk:"node_management_port_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	CloudUrls            types.Set    `tfsdk:"cloud_urls"`
+	Ipv4ConfigType       types.String `tfsdk:"ipv4_config_type"`
+	Ipv4Address          types.String `tfsdk:"ipv4_address"`
+	Ipv4Gateway          types.String `tfsdk:"ipv4_gateway"`
+	Ipv6ConfigType       types.String `tfsdk:"ipv6_config_type"`
+	Ipv6Address          types.String `tfsdk:"ipv6_address"`
+	Ipv6Gateway          types.String `tfsdk:"ipv6_gateway"`
+	DnsAddresses         types.Set    `tfsdk:"dns_addresses"`
+	NtpAddresses         types.Set    `tfsdk:"ntp_addresses"`
+	NoProxy              types.Set    `tfsdk:"no_proxy"`
+	ProxyAddress         types.String `tfsdk:"proxy_address"`
+	ProxyCredentialId    types.String `tfsdk:"proxy_credential_id"`
+	ProxyUsername        types.String `tfsdk:"proxy_username"`
+	ProxyPassword        types.String `tfsdk:"proxy_password"`
+	ConfigOrigin         types.String `tfsdk:"config_origin"`
+	ConnectedState       types.String `tfsdk:"connected_state"`
+	Metadata             types.Object `tfsdk:"metadata"`
+}
+
 func (d *NodeManagementPortDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_node_management_port")
 	resp.TypeName = req.ProviderTypeName + "_node_management_port"
@@ -103,8 +132,9 @@ func (d *NodeManagementPortDataSource) Schema(ctx context.Context, req datasourc
 				Computed:            true,
 			},
 			"proxy_password": schema.StringAttribute{
-				MarkdownDescription: "A password to be used to authenticate to the proxy.",
+				MarkdownDescription: "A password to be used to authenticate to the proxy. The password is never returned by the service.",
 				Computed:            true,
+				Sensitive:           true,
 			},
 			"proxy_credential_id": schema.StringAttribute{
 				MarkdownDescription: "`proxy_credential_id` defines the unique identifier of a set of credentials for the proxy.",
@@ -150,14 +180,20 @@ func (d *NodeManagementPortDataSource) Configure(ctx context.Context, req dataso
 
 func (d *NodeManagementPortDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Start read of datasource: hyperfabric_node_management_port")
-	var data *NodeManagementPortResourceModel
+	var config *NodeManagementPortDataSourceModel
 
 	// Read Terraform configuration data into the model
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data := getEmptyNodeManagementPortResourceModel()
+	data.Id = config.Id
+	data.NodeId = config.NodeId
+	data.NodeManagementPortId = config.NodeManagementPortId
+	data.Name = config.Name
+
 	if data.Name.IsNull() || data.Name.IsUnknown() {
 		data.Name = basetypes.NewStringValue("eth0")
 	}
@@ -181,6 +217,30 @@ func (d *NodeManagementPortDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &NodeManagementPortDataSourceModel{
+		Id:                   data.Id,
+		NodeId:               data.NodeId,
+		NodeManagementPortId: data.NodeManagementPortId,
+		Name:                 data.Name,
+		Description:          data.Description,
+		Enabled:              data.Enabled,
+		CloudUrls:            data.CloudUrls,
+		Ipv4ConfigType:       data.Ipv4ConfigType,
+		Ipv4Address:          data.Ipv4Address,
+		Ipv4Gateway:          data.Ipv4Gateway,
+		Ipv6ConfigType:       data.Ipv6ConfigType,
+		Ipv6Address:          data.Ipv6Address,
+		Ipv6Gateway:          data.Ipv6Gateway,
+		DnsAddresses:         data.DnsAddresses,
+		NtpAddresses:         data.NtpAddresses,
+		NoProxy:              data.NoProxy,
+		ProxyAddress:         data.ProxyAddress,
+		ProxyCredentialId:    data.ProxyCredentialId,
+		ProxyUsername:        data.ProxyUsername,
+		ProxyPassword:        data.ProxyPassword,
+		ConfigOrigin:         data.ConfigOrigin,
+		ConnectedState:       data.ConnectedState,
+		Metadata:             data.Metadata,
+	})...)
 	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_node_management_port with id '%s'", data.Id.ValueString()))
 }
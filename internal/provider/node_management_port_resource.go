@@ -14,6 +14,8 @@ import (
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -33,6 +35,10 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NodeManagementPortResource{}
 var _ resource.ResourceWithImportState = &NodeManagementPortResource{}
+var _ resource.ResourceWithModifyPlan = &NodeManagementPortResource{}
+
+// The key used to store the proxy credential id in the private state once the proxy password has been set by the provider.
+const proxyCredentialIdPrivateKey = "proxy_credential_id"
 
 func NewNodeManagementPortResource() resource.Resource {
 	return &NodeManagementPortResource{}
@@ -49,23 +55,24 @@ type NodeManagementPortResourceModel struct {
 	NodeId               types.String `tfsdk:"node_id"`
 	NodeManagementPortId types.String `tfsdk:"node_management_port_id"`
 	// FabricId             types.String `tfsdk:"fabric_id"`
-	Name              types.String `tfsdk:"name"`
-	Description       types.String `tfsdk:"description"`
-	Enabled           types.Bool   `tfsdk:"enabled"`
-	CloudUrls         types.Set    `tfsdk:"cloud_urls"`
-	Ipv4ConfigType    types.String `tfsdk:"ipv4_config_type"`
-	Ipv4Address       types.String `tfsdk:"ipv4_address"`
-	Ipv4Gateway       types.String `tfsdk:"ipv4_gateway"`
-	Ipv6ConfigType    types.String `tfsdk:"ipv6_config_type"`
-	Ipv6Address       types.String `tfsdk:"ipv6_address"`
-	Ipv6Gateway       types.String `tfsdk:"ipv6_gateway"`
-	DnsAddresses      types.Set    `tfsdk:"dns_addresses"`
-	NtpAddresses      types.Set    `tfsdk:"ntp_addresses"`
-	NoProxy           types.Set    `tfsdk:"no_proxy"`
-	ProxyAddress      types.String `tfsdk:"proxy_address"`
-	ProxyCredentialId types.String `tfsdk:"proxy_credential_id"`
-	ProxyUsername     types.String `tfsdk:"proxy_username"`
-	ProxyPassword     types.String `tfsdk:"proxy_password"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	CloudUrls            types.Set    `tfsdk:"cloud_urls"`
+	Ipv4ConfigType       types.String `tfsdk:"ipv4_config_type"`
+	Ipv4Address          types.String `tfsdk:"ipv4_address"`
+	Ipv4Gateway          types.String `tfsdk:"ipv4_gateway"`
+	Ipv6ConfigType       types.String `tfsdk:"ipv6_config_type"`
+	Ipv6Address          types.String `tfsdk:"ipv6_address"`
+	Ipv6Gateway          types.String `tfsdk:"ipv6_gateway"`
+	DnsAddresses         types.Set    `tfsdk:"dns_addresses"`
+	NtpAddresses         types.Set    `tfsdk:"ntp_addresses"`
+	NoProxy              types.Set    `tfsdk:"no_proxy"`
+	ProxyAddress         types.String `tfsdk:"proxy_address"`
+	ProxyCredentialId    types.String `tfsdk:"proxy_credential_id"`
+	ProxyUsername        types.String `tfsdk:"proxy_username"`
+	ProxyPassword        types.String `tfsdk:"proxy_password"`
+	ProxyPasswordVersion types.Int64  `tfsdk:"proxy_password_version"`
 	// SetProxyPassword  types.Bool   `tfsdk:"set_proxy_password"`
 	ConfigOrigin   types.String `tfsdk:"config_origin"`
 	ConnectedState types.String `tfsdk:"connected_state"`
@@ -80,23 +87,24 @@ func getEmptyNodeManagementPortResourceModel() *NodeManagementPortResourceModel
 		NodeId:               basetypes.NewStringNull(),
 		NodeManagementPortId: basetypes.NewStringNull(),
 		// FabricId:             basetypes.NewStringNull(),
-		Name:              basetypes.NewStringNull(),
-		Description:       basetypes.NewStringNull(),
-		Enabled:           basetypes.NewBoolValue(false),
-		CloudUrls:         basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Ipv4ConfigType:    basetypes.NewStringNull(),
-		Ipv4Address:       basetypes.NewStringNull(),
-		Ipv4Gateway:       basetypes.NewStringNull(),
-		Ipv6ConfigType:    basetypes.NewStringNull(),
-		Ipv6Address:       basetypes.NewStringNull(),
-		Ipv6Gateway:       basetypes.NewStringNull(),
-		DnsAddresses:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		NtpAddresses:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		NoProxy:           basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		ProxyAddress:      basetypes.NewStringNull(),
-		ProxyCredentialId: basetypes.NewStringNull(),
-		ProxyUsername:     basetypes.NewStringNull(),
-		ProxyPassword:     basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolValue(false),
+		CloudUrls:            basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Ipv4ConfigType:       basetypes.NewStringNull(),
+		Ipv4Address:          basetypes.NewStringNull(),
+		Ipv4Gateway:          basetypes.NewStringNull(),
+		Ipv6ConfigType:       basetypes.NewStringNull(),
+		Ipv6Address:          basetypes.NewStringNull(),
+		Ipv6Gateway:          basetypes.NewStringNull(),
+		DnsAddresses:         basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		NtpAddresses:         basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		NoProxy:              basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		ProxyAddress:         basetypes.NewStringNull(),
+		ProxyCredentialId:    basetypes.NewStringNull(),
+		ProxyUsername:        basetypes.NewStringNull(),
+		ProxyPassword:        basetypes.NewStringNull(),
+		ProxyPasswordVersion: basetypes.NewInt64Null(),
 		// SetProxyPassword:  basetypes.NewBoolValue(false),
 		ConfigOrigin:   basetypes.NewStringNull(),
 		ConnectedState: basetypes.NewStringNull(),
@@ -185,8 +193,8 @@ func getNewNodeManagementPortResourceModelFromData(data *NodeManagementPortResou
 		newNodeManagementPort.ProxyUsername = data.ProxyUsername
 	}
 
-	if !data.ProxyPassword.IsNull() && !data.ProxyPassword.IsUnknown() {
-		newNodeManagementPort.ProxyPassword = data.ProxyPassword
+	if !data.ProxyPasswordVersion.IsNull() && !data.ProxyPasswordVersion.IsUnknown() {
+		newNodeManagementPort.ProxyPasswordVersion = data.ProxyPasswordVersion
 	}
 
 	if !data.ConfigOrigin.IsNull() && !data.ConfigOrigin.IsUnknown() {
@@ -309,6 +317,7 @@ func (r *NodeManagementPortResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Validators: []validator.String{
 					// Validate this attribute must be configured with other_attr.
+					IsIpv4Cidr(),
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("ipv4_gateway"),
 						path.MatchRoot("dns_addresses"),
@@ -325,6 +334,7 @@ func (r *NodeManagementPortResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Validators: []validator.String{
 					// Validate this attribute must be configured with other_attr.
+					IsIpv4GatewayInSubnet(path.Root("ipv4_address")),
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("ipv4_address"),
 						path.MatchRoot("dns_addresses"),
@@ -354,6 +364,7 @@ func (r *NodeManagementPortResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Validators: []validator.String{
 					// Validate this attribute must be configured with other_attr.
+					IsIpv6Cidr(),
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("ipv6_gateway"),
 						path.MatchRoot("dns_addresses"),
@@ -370,6 +381,7 @@ func (r *NodeManagementPortResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Validators: []validator.String{
 					// Validate this attribute must be configured with other_attr.
+					IsIpv6GatewayInSubnet(path.Root("ipv6_address")),
 					stringvalidator.AlsoRequires(path.Expressions{
 						path.MatchRoot("ipv6_address"),
 						path.MatchRoot("dns_addresses"),
@@ -405,12 +417,18 @@ func (r *NodeManagementPortResource) Schema(ctx context.Context, req resource.Sc
 				},
 			},
 			"proxy_password": schema.StringAttribute{
-				MarkdownDescription: "A password to be used to authenticate to the proxy.",
+				MarkdownDescription: "A password to be used to authenticate to the proxy. The password is write-only and never stored in the state. It is only sent when the Management Port is created, when `proxy_username` or `proxy_password_version` change, or when the proxy credentials were changed outside of Terraform.",
 				Optional:            true,
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"proxy_password_version": schema.Int64Attribute{
+				MarkdownDescription: "A version of the `proxy_password` that triggers the rotation of the password when changed.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.Expressions{
+						path.MatchRoot("proxy_password"),
+					}...),
 				},
 			},
 			"proxy_credential_id": schema.StringAttribute{
@@ -477,6 +495,9 @@ func getDnsAddressesSchemaAttribute() schema.SetAttribute {
 		PlanModifiers: []planmodifier.Set{
 			setplanmodifier.UseStateForUnknown(),
 		},
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsIpAddress()),
+		},
 		ElementType: types.StringType,
 	}
 }
@@ -497,6 +518,9 @@ func getNtpAddressesSchemaAttribute() schema.SetAttribute {
 		PlanModifiers: []planmodifier.Set{
 			setplanmodifier.UseStateForUnknown(),
 		},
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsIpAddressOrHostname()),
+		},
 		ElementType: types.StringType,
 	}
 }
@@ -529,6 +553,33 @@ func getNoProxyDataSourceSchemaAttribute() schema.SetAttribute {
 	}
 }
 
+func (r *NodeManagementPortResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		var planData, stateData *NodeManagementPortResourceModel
+		var proxyPassword types.String
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proxy_password"), &proxyPassword)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if isProxyCredentialChanged(ctx, req.Private, stateData) && !proxyPassword.IsNull() {
+			resp.Diagnostics.AddWarning(
+				"Proxy credentials changed outside of Terraform",
+				fmt.Sprintf("The proxy credential id of the Management Port '%s' changed to '%s' since the proxy password was last set by Terraform. The configured proxy password will be set again.", stateData.Id.ValueString(), stateData.ProxyCredentialId.ValueString()),
+			)
+		}
+
+		// The proxy credential id changes when a new proxy password is set
+		if isProxyPasswordRequired(ctx, req.Private, planData, stateData, proxyPassword) {
+			planData.ProxyCredentialId = basetypes.NewStringUnknown()
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+		}
+	}
+}
+
 func (r *NodeManagementPortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: hyperfabric_node_management_port")
 	// Prevent panic if the provider has not been configured.
@@ -562,6 +613,12 @@ func (r *NodeManagementPortResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proxy_password"), &data.ProxyPassword)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_node_management_port with name '%s'", data.Name.ValueString()))
 
 	jsonPayload := getNodeManagementPortJsonPayload(ctx, &resp.Diagnostics, data, "create")
@@ -584,6 +641,7 @@ func (r *NodeManagementPortResource) Create(ctx context.Context, req resource.Cr
 		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/managementPorts/%s", data.NodeId.ValueString(), managementPortId))
 		data.NodeManagementPortId = basetypes.NewStringValue(managementPortId)
 		getAndSetNodeManagementPortAttributes(ctx, &resp.Diagnostics, r.client, data)
+		setWriteOnlySecretPrivateState(ctx, &resp.Diagnostics, resp.Private, proxyCredentialIdPrivateKey, data.ProxyCredentialId.ValueString())
 	} else {
 		data.Id = basetypes.NewStringNull()
 	}
@@ -608,6 +666,12 @@ func (r *NodeManagementPortResource) Read(ctx context.Context, req resource.Read
 	checkAndSetNodeManagementPortIds(data)
 	getAndSetNodeManagementPortAttributes(ctx, &resp.Diagnostics, r.client, data)
 
+	// Start tracking the proxy credential id of Management Ports created before the proxy password was write-only
+	var proxyCredentialId string
+	if !getWriteOnlySecretPrivateState(ctx, req.Private, proxyCredentialIdPrivateKey, &proxyCredentialId) && !data.Id.IsNull() {
+		setWriteOnlySecretPrivateState(ctx, &resp.Diagnostics, resp.Private, proxyCredentialIdPrivateKey, data.ProxyCredentialId.ValueString())
+	}
+
 	// Save updated data into Terraform state
 	if data.Id.IsNull() {
 		var emptyData *NodeManagementPortResourceModel
@@ -633,6 +697,16 @@ func (r *NodeManagementPortResource) Update(ctx context.Context, req resource.Up
 
 	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_node_management_port with id '%s'", data.Id.ValueString()))
 
+	// The write-only proxy password is only sent when it has to be set again
+	var proxyPassword types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proxy_password"), &proxyPassword)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if isProxyPasswordRequired(ctx, req.Private, data, stateData, proxyPassword) {
+		data.ProxyPassword = proxyPassword
+	}
+
 	jsonPayload := getNodeManagementPortJsonPayload(ctx, &resp.Diagnostics, data, "update")
 
 	if resp.Diagnostics.HasError() {
@@ -646,6 +720,7 @@ func (r *NodeManagementPortResource) Update(ctx context.Context, req resource.Up
 	}
 
 	getAndSetNodeManagementPortAttributes(ctx, &resp.Diagnostics, r.client, data)
+	setWriteOnlySecretPrivateState(ctx, &resp.Diagnostics, resp.Private, proxyCredentialIdPrivateKey, data.ProxyCredentialId.ValueString())
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -899,3 +974,20 @@ func checkAndSetNodeManagementPortIds(data *NodeManagementPortResourceModel) {
 		data.NodeId = data.Id
 	}
 }
+
+// isProxyCredentialChanged returns true when the proxy credential id of the Management Port differs from the id recorded
+// in the private state when the proxy password was last set by the provider.
+func isProxyCredentialChanged(ctx context.Context, private privateStateGetter, stateData *NodeManagementPortResourceModel) bool {
+	var proxyCredentialId string
+	return getWriteOnlySecretPrivateState(ctx, private, proxyCredentialIdPrivateKey, &proxyCredentialId) && proxyCredentialId != stateData.ProxyCredentialId.ValueString()
+}
+
+// isProxyPasswordRequired returns true when the configured write-only proxy password has to be sent to the Fabric.
+func isProxyPasswordRequired(ctx context.Context, private privateStateGetter, planData, stateData *NodeManagementPortResourceModel, proxyPassword types.String) bool {
+	if stateData == nil {
+		return !proxyPassword.IsNull()
+	}
+	outdated := planData.ProxyUsername.IsUnknown() || planData.ProxyUsername.ValueString() != stateData.ProxyUsername.ValueString() ||
+		isProxyCredentialChanged(ctx, private, stateData)
+	return isWriteOnlySecretRequired(proxyPassword, planData.ProxyPasswordVersion, stateData.ProxyPasswordVersion, outdated)
+}
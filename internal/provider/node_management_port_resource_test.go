@@ -8,10 +8,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccNodeManagementPortResource(t *testing.T) {
@@ -20,6 +22,14 @@ func TestAccNodeManagementPortResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Validate that invalid IP addresses and gateways outside of the subnet are rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Management Port - Validate that invalid IP addresses and gateways outside of the subnet are rejected during plan.")
+				},
+				Config:      testNodeManagementPortResourceHclConfig(fabricName, "invalid"),
+				ExpectError: regexp.MustCompile(`Invalid Gateway Address`),
+			},
 			// Create with minimum config and verify provided and default Hyperfabric values.
 			{
 				PreConfig: func() {
@@ -61,7 +71,6 @@ func TestAccNodeManagementPortResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "no_proxy.1", "server.local"),
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_address", "http://proxy.mycompany.com:80"),
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_username", "my_proxy_user"),
-					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_password", "my_super_secret_password"),
 				),
 			},
 			// Update with minimum config and verify config is unchanged.
@@ -93,7 +102,6 @@ func TestAccNodeManagementPortResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "no_proxy.1", "server.local"),
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_address", "http://proxy.mycompany.com:80"),
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_username", "my_proxy_user"),
-					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_password", "my_super_secret_password"),
 				),
 			},
 			// ImportState testing with pre-existing Id.
@@ -149,7 +157,6 @@ func TestAccNodeManagementPortResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "no_proxy.#", "0"),
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_address", ""),
 					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_username", ""),
-					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_password", ""),
 				),
 			},
 			// Run Plan Only with minimal config and check that plan is empty.
@@ -170,6 +177,67 @@ func TestAccNodeManagementPortResource(t *testing.T) {
 	})
 }
 
+func TestAccNodeManagementPortResourceProxyPassword(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		// The write-only proxy_password attribute is only available in Terraform 1.11 and later.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a proxy password and verify the password is not stored in the state.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Management Port - Create with a proxy password and verify the password is not stored in the state.")
+				},
+				Config:             testNodeManagementPortResourceHclConfig(fabricName, "password"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_username", "my_proxy_user"),
+					resource.TestCheckNoResourceAttr("hyperfabric_node_management_port.test", "proxy_password"),
+					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_password_version", "1"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_management_port.test", "proxy_credential_id"),
+				),
+			},
+			// Run Plan Only with the same proxy password version and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Management Port - Run Plan Only with the same proxy password version and check that plan is empty.")
+				},
+				Config:             testNodeManagementPortResourceHclConfig(fabricName, "password"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Update the proxy password version and verify the password is not stored in the state.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Management Port - Update the proxy password version and verify the password is not stored in the state.")
+				},
+				Config:             testNodeManagementPortResourceHclConfig(fabricName, "rotate"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_username", "my_proxy_user"),
+					resource.TestCheckNoResourceAttr("hyperfabric_node_management_port.test", "proxy_password"),
+					resource.TestCheckResourceAttr("hyperfabric_node_management_port.test", "proxy_password_version", "2"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_management_port.test", "proxy_credential_id"),
+				),
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Management Port - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:            "hyperfabric_node_management_port.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"proxy_password", "proxy_password_version"},
+			},
+		},
+	})
+}
+
 func testNodeManagementPortResourceHclConfig(fabricName string, configType string) string {
 	if configType == "full" {
 		return fmt.Sprintf(`
@@ -199,7 +267,46 @@ resource "hyperfabric_node_management_port" "test" {
 	no_proxy         = ["10.0.0.1", "server.local"]
 	proxy_address    = "http://proxy.mycompany.com:80"
 	proxy_username   = "my_proxy_user"
-	proxy_password   = "my_super_secret_password"
+}
+`, fabricName)
+	} else if configType == "password" {
+		return fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_node_management_port" "test" {
+	node_id                = hyperfabric_node.test.id
+	proxy_username         = "my_proxy_user"
+	proxy_password         = "my_super_secret_password"
+	proxy_password_version = 1
+}
+`, fabricName)
+	} else if configType == "rotate" {
+		return fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_node_management_port" "test" {
+	node_id                = hyperfabric_node.test.id
+	proxy_username         = "my_proxy_user"
+	proxy_password         = "my_rotated_super_secret_password"
+	proxy_password_version = 2
 }
 `, fabricName)
 	} else if configType == "clear" {
@@ -230,7 +337,27 @@ resource "hyperfabric_node_management_port" "test" {
 	no_proxy         = []
 	proxy_address    = ""
 	proxy_username   = ""
-	proxy_password   = ""
+}
+`, fabricName)
+	} else if configType == "invalid" {
+		return fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_node_management_port" "test" {
+	node_id          = hyperfabric_node.test.id
+	ipv4_config_type = "CONFIG_TYPE_STATIC"
+	ipv4_address     = "10.0.0.3/24"
+	ipv4_gateway     = "10.0.1.254"
+	dns_addresses    = ["8.8.8.8"]
 }
 `, fabricName)
 	} else if configType == "minimal+" {
@@ -73,13 +73,13 @@ func (d *NodePortDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			// 	MarkdownDescription: "The index of the sub-port on the breakout Port.",
 			// 	Computed:            true,
 			// },
-			"index": schema.Float64Attribute{
+			"index": schema.Int64Attribute{
 				MarkdownDescription: "The index number of the Port of the Node.",
 				Computed:            true,
 			},
 			"ipv4_addresses": getIpv4AddressesDataSourceSchemaAttribute(),
 			"ipv6_addresses": getIpv6AddressesDataSourceSchemaAttribute(),
-			"linecard": schema.Float64Attribute{
+			"linecard": schema.Int64Attribute{
 				MarkdownDescription: "The linecard index number of the Port of the Node.",
 				Computed:            true,
 			},
@@ -104,7 +104,7 @@ func (d *NodePortDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "The maximum speed of the Port of the Node.",
 				Computed:            true,
 			},
-			"mtu": schema.Float64Attribute{
+			"mtu": schema.Int64Attribute{
 				MarkdownDescription: "The MTU of the Port of the Node.",
 				Computed:            true,
 			},
@@ -113,7 +113,7 @@ func (d *NodePortDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "The configured speed of the Port of the Node.",
 				Computed:            true,
 			},
-			"sub_interfaces_count": schema.Float64Attribute{
+			"sub_interfaces_count": schema.Int64Attribute{
 				MarkdownDescription: "The number of sub-interfaces of the Port of the Node.",
 				Computed:            true,
 			},
@@ -123,9 +123,10 @@ func (d *NodePortDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "The `vrf_id` of a VRF to associate with the Port of the Node. Required when the Port roles include `ROUTED_PORT`.",
 				Computed:            true,
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_port")
@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -35,6 +35,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NodePortResource{}
 var _ resource.ResourceWithImportState = &NodePortResource{}
+var _ resource.ResourceWithUpgradeState = &NodePortResource{}
 
 func NewNodePortResource() resource.Resource {
 	return &NodePortResource{}
@@ -55,25 +56,26 @@ type NodePortResourceModel struct {
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	// Breakout           types.Bool    `tfsdk:"breakout"`
 	// BreakoutIndex      types.Float64 `tfsdk:"breakout_index"`
-	Index              types.Float64                     `tfsdk:"index"`
-	Ipv4Addresses      types.Set                         `tfsdk:"ipv4_addresses"`
-	Ipv6Addresses      types.Set                         `tfsdk:"ipv6_addresses"`
-	Linecard           types.Float64                     `tfsdk:"linecard"`
-	PreventForwarding  types.Bool                        `tfsdk:"prevent_forwarding"`
-	LldpHost           types.String                      `tfsdk:"lldp_host"`
-	LldpInfo           types.String                      `tfsdk:"lldp_info"`
-	LldpPort           types.String                      `tfsdk:"lldp_port"`
-	MaxSpeed           types.String                      `tfsdk:"max_speed"`
-	Mtu                types.Float64                     `tfsdk:"mtu"`
-	Roles              types.Set                         `tfsdk:"roles"`
-	Speed              types.String                      `tfsdk:"speed"`
-	SubInterfacesCount types.Float64                     `tfsdk:"sub_interfaces_count"`
-	VlanIds            types.Set                         `tfsdk:"vlan_ids"`
-	Vnis               types.Set                         `tfsdk:"vnis"`
-	VrfId              customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
-	Metadata           types.Object                      `tfsdk:"metadata"`
-	Labels             types.Set                         `tfsdk:"labels"`
-	Annotations        types.Set                         `tfsdk:"annotations"`
+	Index                types.Int64                       `tfsdk:"index"`
+	Ipv4Addresses        types.Set                         `tfsdk:"ipv4_addresses"`
+	Ipv6Addresses        types.Set                         `tfsdk:"ipv6_addresses"`
+	Linecard             types.Int64                       `tfsdk:"linecard"`
+	PreventForwarding    types.Bool                        `tfsdk:"prevent_forwarding"`
+	LldpHost             types.String                      `tfsdk:"lldp_host"`
+	LldpInfo             types.String                      `tfsdk:"lldp_info"`
+	LldpPort             types.String                      `tfsdk:"lldp_port"`
+	MaxSpeed             types.String                      `tfsdk:"max_speed"`
+	Mtu                  types.Int64                       `tfsdk:"mtu"`
+	Roles                types.Set                         `tfsdk:"roles"`
+	Speed                types.String                      `tfsdk:"speed"`
+	SubInterfacesCount   types.Int64                       `tfsdk:"sub_interfaces_count"`
+	VlanIds              types.Set                         `tfsdk:"vlan_ids"`
+	Vnis                 types.Set                         `tfsdk:"vnis"`
+	VrfId                customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	Metadata             types.Object                      `tfsdk:"metadata"`
+	Labels               types.Set                         `tfsdk:"labels"`
+	Annotations          types.Set                         `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool                        `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyNodePortResourceModel() *NodePortResourceModel {
@@ -86,25 +88,26 @@ func getEmptyNodePortResourceModel() *NodePortResourceModel {
 		Enabled:     basetypes.NewBoolValue(false),
 		// Breakout:           basetypes.NewBoolValue(false),
 		// BreakoutIndex:      basetypes.NewFloat64Null(),
-		Index:              basetypes.NewFloat64Null(),
-		Ipv4Addresses:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Ipv6Addresses:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Linecard:           basetypes.NewFloat64Null(),
-		PreventForwarding:  basetypes.NewBoolValue(false),
-		LldpHost:           basetypes.NewStringNull(),
-		LldpInfo:           basetypes.NewStringNull(),
-		LldpPort:           basetypes.NewStringNull(),
-		MaxSpeed:           basetypes.NewStringNull(),
-		Mtu:                basetypes.NewFloat64Null(),
-		Roles:              basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Speed:              basetypes.NewStringNull(),
-		SubInterfacesCount: basetypes.NewFloat64Null(),
-		VlanIds:            basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Vnis:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		VrfId:              customTypes.NewUuidFromIdStringNull(),
-		Metadata:           basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:             basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations:        basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Index:                basetypes.NewInt64Null(),
+		Ipv4Addresses:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Ipv6Addresses:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Linecard:             basetypes.NewInt64Null(),
+		PreventForwarding:    basetypes.NewBoolValue(false),
+		LldpHost:             basetypes.NewStringNull(),
+		LldpInfo:             basetypes.NewStringNull(),
+		LldpPort:             basetypes.NewStringNull(),
+		MaxSpeed:             basetypes.NewStringNull(),
+		Mtu:                  basetypes.NewInt64Null(),
+		Roles:                basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Speed:                basetypes.NewStringNull(),
+		SubInterfacesCount:   basetypes.NewInt64Null(),
+		VlanIds:              basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Vnis:                 basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		VrfId:                customTypes.NewUuidFromIdStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -219,6 +222,10 @@ func getNewNodePortResourceModelFromData(data *NodePortResourceModel) *NodePortR
 		newNodePort.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newNodePort.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newNodePort
 }
 
@@ -226,6 +233,23 @@ type NodePortIdentifier struct {
 	Id types.String
 }
 
+func (r *NodePortResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *NodePortResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Validate the name against the model of the Node only when the name is planned to change
+		if !planData.Name.IsUnknown() && (stateData == nil || stateData.Name.ValueString() != planData.Name.ValueString()) {
+			checkPortNamesForNodeModel(ctx, &resp.Diagnostics, r.client, planData.NodeId, path.Root("name"), []string{planData.Name.ValueString()}, false)
+		}
+	}
+}
+
 func (r *NodePortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_node_port")
 	resp.TypeName = req.ProviderTypeName + "_node_port"
@@ -237,6 +261,7 @@ func (r *NodePortResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Node Port resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -266,6 +291,9 @@ func (r *NodePortResource) Schema(ctx context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					IsPortName(),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description is a user defined field to store notes about the Port of the Node.",
@@ -301,20 +329,20 @@ func (r *NodePortResource) Schema(ctx context.Context, req resource.SchemaReques
 			// 		float64planmodifier.UseStateForUnknown(),
 			// 	},
 			// },
-			"index": schema.Float64Attribute{
+			"index": schema.Int64Attribute{
 				MarkdownDescription: "The index number of the Port of the Node.",
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"ipv4_addresses": getIpv4AddressesSchemaAttribute(),
 			"ipv6_addresses": getIpv6AddressesSchemaAttribute(),
-			"linecard": schema.Float64Attribute{
+			"linecard": schema.Int64Attribute{
 				MarkdownDescription: "The linecard index number of the Port of the Node.",
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"prevent_forwarding": schema.BoolAttribute{
@@ -353,12 +381,12 @@ func (r *NodePortResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"mtu": schema.Float64Attribute{
+			"mtu": schema.Int64Attribute{
 				MarkdownDescription: "The MTU of the Port of the Node.",
 				// Optional:            true,
 				Computed: true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"roles": getPortRolesSchemaAttribute(),
@@ -369,11 +397,11 @@ func (r *NodePortResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"sub_interfaces_count": schema.Float64Attribute{
+			"sub_interfaces_count": schema.Int64Attribute{
 				MarkdownDescription: "The number of sub-interfaces of the Port of the Node.",
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"vlan_ids": getVlanIdsSchemaAttribute(),
@@ -389,9 +417,10 @@ func (r *NodePortResource) Schema(ctx context.Context, req resource.SchemaReques
 					CompareUuidWithIdForEquality(),
 				},
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_node_port")
@@ -406,6 +435,7 @@ func getIpv4AddressesSchemaAttribute() schema.SetAttribute {
 			setplanmodifier.UseStateForUnknown(),
 		},
 		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsIpv4Cidr()),
 			// Validate this attribute must be configured with other_attr.
 			setvalidator.AlsoRequires(path.Expressions{
 				path.MatchRoot("vrf_id"),
@@ -432,6 +462,7 @@ func getIpv6AddressesSchemaAttribute() schema.SetAttribute {
 			setplanmodifier.UseStateForUnknown(),
 		},
 		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsIpv6Cidr()),
 			// Validate this attribute must be configured with other_attr.
 			setvalidator.AlsoRequires(path.Expressions{
 				path.MatchRoot("vrf_id"),
@@ -484,6 +515,14 @@ func getVnisSchemaAttribute() schema.SetAttribute {
 	}
 }
 
+func (r *NodePortResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeFloat64StateToInt64,
+		},
+	}
+}
+
 func (r *NodePortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: hyperfabric_node_port")
 	// Prevent panic if the provider has not been configured.
@@ -524,6 +563,16 @@ func (r *NodePortResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "hyperfabric_node_port", "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), jsonPayload, basetypes.NewSetNull(SetStringResourceModelAttributeType()), basetypes.NewSetNull(AnnotationResourceModelAttributeType()), data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "PUT", jsonPayload)
 	if resp.Diagnostics.HasError() {
 		return
@@ -589,6 +638,16 @@ func (r *NodePortResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "hyperfabric_node_port", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -616,28 +675,39 @@ func (r *NodePortResource) Delete(ctx context.Context, req resource.DeleteReques
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node_port with id '%s'", data.Id.ValueString()))
 	checkAndSetNodePortIds(data)
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "hyperfabric_node_port", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resetNodePort(ctx, &resp.Diagnostics, r.client, data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_node_port with id '%s'", data.Id.ValueString()))
+}
+
+// resetNodePort restores the default configuration of a Port. A Fabric Port is set to unused first as the role of a
+// Fabric Port cannot be removed while it is in use.
+func resetNodePort(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *NodePortResourceModel) {
 	for _, role := range getSetStringJsonPayload(ctx, data.Roles) {
 		if role == "FABRIC_PORT" {
 			data.Roles = NewSetString(ctx, append(make([]interface{}, 0), "UNUSED_PORT"))
-			jsonPayload := getNodePortJsonPayload(ctx, &resp.Diagnostics, data, "update")
+			jsonPayload := getNodePortJsonPayload(ctx, diags, data, "update")
 
-			if resp.Diagnostics.HasError() {
+			if diags.HasError() {
 				return
 			}
 
-			DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "PUT", jsonPayload)
+			DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "PUT", jsonPayload)
 
-			if resp.Diagnostics.HasError() {
+			if diags.HasError() {
 				return
 			}
 		}
 	}
 
-	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "DELETE", nil)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_node_port with id '%s'", data.Id.ValueString()))
+	DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), data.Name.ValueString()), "DELETE", nil)
 }
 
 func (r *NodePortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -666,13 +736,23 @@ func getAndSetNodePortAttributes(ctx context.Context, diags *diag.Diagnostics, c
 		return
 	}
 
+	var attributes map[string]interface{}
+	if requestData.Data() != nil {
+		attributes = requestData.Data().(map[string]interface{})
+	}
+	setNodePortAttributes(ctx, data, attributes)
+}
+
+// setNodePortAttributes sets the data with the attributes of a Port returned by the API. The id of the data is set to
+// null when no attributes are provided.
+func setNodePortAttributes(ctx context.Context, data *NodePortResourceModel, attributes map[string]interface{}) {
 	newNodePort := *getNewNodePortResourceModelFromData(data)
 	node := getEmptyNodeResourceModel()
 	node.Id = newNodePort.NodeId
 	checkAndSetNodeIds(node)
 
-	if requestData.Data() != nil {
-		for attributeName, attributeValue := range requestData.Data().(map[string]interface{}) {
+	if attributes != nil {
+		for attributeName, attributeValue := range attributes {
 			if attributeName == "id" && (data.PortId.IsNull() || data.PortId.IsUnknown() || data.PortId.ValueString() == "" || data.PortId.ValueString() != attributeValue.(string)) {
 				newNodePort.PortId = basetypes.NewStringValue(attributeValue.(string))
 				newNodePort.NodeId = basetypes.NewStringValue(fmt.Sprintf("%s/nodes/%s", node.FabricId.ValueString(), node.NodeId.ValueString()))
@@ -694,15 +774,15 @@ func getAndSetNodePortAttributes(ctx context.Context, diags *diag.Diagnostics, c
 				// } else if attributeName == "breakout" {
 				// 	newNodePort.Breakout = basetypes.NewBoolValue(attributeValue.(bool))
 				// } else if attributeName == "breakoutIndex" {
-				// 	newNodePort.BreakoutIndex = basetypes.NewFloat64Value(attributeValue.(float64))
+				// 	newNodePort.BreakoutIndex = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "index" {
-				newNodePort.Index = basetypes.NewFloat64Value(attributeValue.(float64))
+				newNodePort.Index = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "ipv4Addresses" {
 				newNodePort.Ipv4Addresses = NewSetString(ctx, attributeValue.([]interface{}))
 			} else if attributeName == "ipv6Addresses" {
 				newNodePort.Ipv6Addresses = NewSetString(ctx, attributeValue.([]interface{}))
 			} else if attributeName == "linecard" {
-				newNodePort.Linecard = basetypes.NewFloat64Value(attributeValue.(float64))
+				newNodePort.Linecard = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "linkDown" {
 				newNodePort.PreventForwarding = basetypes.NewBoolValue(attributeValue.(bool))
 			} else if attributeName == "lldpHost" {
@@ -714,13 +794,13 @@ func getAndSetNodePortAttributes(ctx context.Context, diags *diag.Diagnostics, c
 			} else if attributeName == "maxSpeed" {
 				newNodePort.MaxSpeed = basetypes.NewStringValue(attributeValue.(string))
 			} else if attributeName == "mtu" {
-				newNodePort.Mtu = basetypes.NewFloat64Value(attributeValue.(float64))
+				newNodePort.Mtu = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "roles" {
 				newNodePort.Roles = NewSetString(ctx, attributeValue.([]interface{}))
 			} else if attributeName == "speed" {
 				newNodePort.Speed = basetypes.NewStringValue(attributeValue.(string))
 			} else if attributeName == "subInfCount" {
-				newNodePort.SubInterfacesCount = basetypes.NewFloat64Value(attributeValue.(float64))
+				newNodePort.SubInterfacesCount = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "vlanIds" {
 				newNodePort.VlanIds = NewSetString(ctx, attributeValue.([]interface{}))
 			} else if attributeName == "vnis" {
@@ -730,9 +810,9 @@ func getAndSetNodePortAttributes(ctx context.Context, diags *diag.Diagnostics, c
 			} else if attributeName == "metadata" {
 				newNodePort.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newNodePort.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newNodePort.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newNodePort.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newNodePort.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
@@ -781,7 +861,7 @@ func getNodePortJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
@@ -8,6 +8,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -20,6 +21,14 @@ func TestAccNodePortResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Validate that invalid Port names are rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Port - Validate that invalid Port names are rejected during plan.")
+				},
+				Config:      testNodePortResourceHclConfig(fabricName, "invalid"),
+				ExpectError: regexp.MustCompile(`Invalid Port Name`),
+			},
 			// Create with minimum config and verify provided and default Hyperfabric values.
 			{
 				PreConfig: func() {
@@ -208,6 +217,25 @@ resource "hyperfabric_node_port" "test_fabric_port" {
 	name    = "Ethernet1_2"
 	roles   = ["FABRIC_PORT"]
 }
+`, fabricName)
+	} else if configType == "invalid" {
+		return fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_node_port" "test" {
+	node_id = hyperfabric_node.test.id
+	name    = "Eth1/1"
+	roles   = ["ROUTED_PORT"]
+}
 `, fabricName)
 	} else if configType == "minimal+" {
 		return fmt.Sprintf(`
@@ -0,0 +1,484 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodePortStatusDataSource{}
+
+func NewNodePortStatusDataSource() datasource.DataSource {
+	return &NodePortStatusDataSource{}
+}
+
+// NodePortStatusDataSource defines the data source implementation.
+type NodePortStatusDataSource struct {
+	client *client.Client
+}
+
+// NodePortStatusDataSourceModel describes the data source data model.
+type NodePortStatusDataSourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	NodeId types.String `tfsdk:"node_id"`
+	Names  types.Set    `tfsdk:"names"`
+	AllUp  types.Bool   `tfsdk:"all_up"`
+	Ports  types.List   `tfsdk:"ports"`
+}
+
+// NodePortStatusPortDataSourceModel describes the configuration and the operational status of a port of a Node.
+type NodePortStatusPortDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	PortId          types.String `tfsdk:"port_id"`
+	Name            types.String `tfsdk:"name"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Speed           types.String `tfsdk:"speed"`
+	MaxSpeed        types.String `tfsdk:"max_speed"`
+	Mtu             types.Int64  `tfsdk:"mtu"`
+	OperState       types.String `tfsdk:"oper_state"`
+	Up              types.Bool   `tfsdk:"up"`
+	NegotiatedSpeed types.String `tfsdk:"negotiated_speed"`
+	LastFlap        types.String `tfsdk:"last_flap"`
+	FlapCount       types.Int64  `tfsdk:"flap_count"`
+	Transceiver     types.Object `tfsdk:"transceiver"`
+	Counters        types.Object `tfsdk:"counters"`
+}
+
+// NodePortStatusTransceiverDataSourceModel describes the transceiver of a port and its Digital Optical Monitoring (DOM) readings.
+type NodePortStatusTransceiverDataSourceModel struct {
+	Type         types.String  `tfsdk:"type"`
+	Vendor       types.String  `tfsdk:"vendor"`
+	PartNumber   types.String  `tfsdk:"part_number"`
+	SerialNumber types.String  `tfsdk:"serial_number"`
+	Temperature  types.Float64 `tfsdk:"temperature"`
+	Voltage      types.Float64 `tfsdk:"voltage"`
+	TxPower      types.Float64 `tfsdk:"tx_power"`
+	RxPower      types.Float64 `tfsdk:"rx_power"`
+}
+
+// NodePortStatusCountersDataSourceModel describes the error counters of a port.
+type NodePortStatusCountersDataSourceModel struct {
+	InErrors    types.Int64 `tfsdk:"in_errors"`
+	OutErrors   types.Int64 `tfsdk:"out_errors"`
+	InDiscards  types.Int64 `tfsdk:"in_discards"`
+	OutDiscards types.Int64 `tfsdk:"out_discards"`
+	CrcErrors   types.Int64 `tfsdk:"crc_errors"`
+}
+
+// {
+// 	"operState": "UP",
+// 	"speed": "100G",
+// 	"lastFlap": "2024-10-01T10:00:00Z",
+// 	"flapCount": 2,
+// 	"transceiver": {
+// 		"type": "QSFP-100G-SR4-S",
+// 		"vendor": "CISCO",
+// 		"partNumber": "10-3143-01",
+// 		"serialNumber": "AVF1234ABCD",
+// 		"temperature": 34.5,
+// 		"voltage": 3.29,
+// 		"txPower": -0.8,
+// 		"rxPower": -1.6
+// 	},
+// 	"counters": {
+// 		"inErrors": 0,
+// 		"outErrors": 0,
+// 		"inDiscards": 0,
+// 		"outDiscards": 0,
+// 		"crcErrors": 0
+// 	}
+// }
+
+func NodePortStatusPortDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":               types.StringType,
+			"port_id":          types.StringType,
+			"name":             types.StringType,
+			"enabled":          types.BoolType,
+			"speed":            types.StringType,
+			"max_speed":        types.StringType,
+			"mtu":              types.Int64Type,
+			"oper_state":       types.StringType,
+			"up":               types.BoolType,
+			"negotiated_speed": types.StringType,
+			"last_flap":        types.StringType,
+			"flap_count":       types.Int64Type,
+			"transceiver":      NodePortStatusTransceiverDataSourceModelAttributeType(),
+			"counters":         NodePortStatusCountersDataSourceModelAttributeType(),
+		},
+	}
+}
+
+func NodePortStatusTransceiverDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":          types.StringType,
+			"vendor":        types.StringType,
+			"part_number":   types.StringType,
+			"serial_number": types.StringType,
+			"temperature":   types.Float64Type,
+			"voltage":       types.Float64Type,
+			"tx_power":      types.Float64Type,
+			"rx_power":      types.Float64Type,
+		},
+	}
+}
+
+func NodePortStatusCountersDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"in_errors":    types.Int64Type,
+			"out_errors":   types.Int64Type,
+			"in_discards":  types.Int64Type,
+			"out_discards": types.Int64Type,
+			"crc_errors":   types.Int64Type,
+		},
+	}
+}
+
+func (d *NodePortStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_node_port_status")
+	resp.TypeName = req.ProviderTypeName + "_node_port_status"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_node_port_status")
+}
+
+func (d *NodePortStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_node_port_status")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Node Port Status data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `node_id` followed by `/ports/status`.",
+				Computed:            true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "`node_id` defines the unique identifier of a Node in a Fabric.",
+				Required:            true,
+			},
+			"names": schema.SetAttribute{
+				MarkdownDescription: "The names of the ports of the Node to return the status of.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(IsPortName()),
+				},
+			},
+			"all_up": schema.BoolAttribute{
+				MarkdownDescription: "Whether the operational state of all the ports is `UP`.",
+				Computed:            true,
+			},
+			"ports": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of the configuration and operational status of the ports sorted by linecard and index.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Port of a Node in a Fabric.",
+							Computed:            true,
+						},
+						"port_id": schema.StringAttribute{
+							MarkdownDescription: "`port_id` defines the unique identifier of a Port.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Port.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The administrative state of the Port.",
+							Computed:            true,
+						},
+						"speed": schema.StringAttribute{
+							MarkdownDescription: "The configured speed of the Port.",
+							Computed:            true,
+						},
+						"max_speed": schema.StringAttribute{
+							MarkdownDescription: "The maximum speed of the Port.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "The configured Maximum Transmission Unit (MTU) of the Port.",
+							Computed:            true,
+						},
+						"oper_state": schema.StringAttribute{
+							MarkdownDescription: "The operational state of the Port (i.e. `UP` or `DOWN`). Null when the Node has not been bound to a Device yet.",
+							Computed:            true,
+						},
+						"up": schema.BoolAttribute{
+							MarkdownDescription: "Whether the operational state of the Port is `UP`.",
+							Computed:            true,
+						},
+						"negotiated_speed": schema.StringAttribute{
+							MarkdownDescription: "The speed negotiated on the link of the Port.",
+							Computed:            true,
+						},
+						"last_flap": schema.StringAttribute{
+							MarkdownDescription: "The timestamp of the last change of the operational state of the Port in RFC3339 format.",
+							Computed:            true,
+						},
+						"flap_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of changes of the operational state of the Port. Null when the status of the Port does not report it.",
+							Computed:            true,
+						},
+						"transceiver": schema.SingleNestedAttribute{
+							MarkdownDescription: "The transceiver inserted in the Port and its Digital Optical Monitoring (DOM) readings. Null when no transceiver is detected.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the transceiver.",
+									Computed:            true,
+								},
+								"vendor": schema.StringAttribute{
+									MarkdownDescription: "The vendor of the transceiver.",
+									Computed:            true,
+								},
+								"part_number": schema.StringAttribute{
+									MarkdownDescription: "The part number of the transceiver.",
+									Computed:            true,
+								},
+								"serial_number": schema.StringAttribute{
+									MarkdownDescription: "The serial number of the transceiver.",
+									Computed:            true,
+								},
+								"temperature": schema.Float64Attribute{
+									MarkdownDescription: "The temperature of the transceiver in degrees Celsius.",
+									Computed:            true,
+								},
+								"voltage": schema.Float64Attribute{
+									MarkdownDescription: "The supply voltage of the transceiver in volts.",
+									Computed:            true,
+								},
+								"tx_power": schema.Float64Attribute{
+									MarkdownDescription: "The transmit power of the transceiver in dBm.",
+									Computed:            true,
+								},
+								"rx_power": schema.Float64Attribute{
+									MarkdownDescription: "The receive power of the transceiver in dBm.",
+									Computed:            true,
+								},
+							},
+						},
+						"counters": schema.SingleNestedAttribute{
+							MarkdownDescription: "The error counters of the Port. Null when the status of the Port does not report them.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"in_errors": schema.Int64Attribute{
+									MarkdownDescription: "The number of received packets with errors.",
+									Computed:            true,
+								},
+								"out_errors": schema.Int64Attribute{
+									MarkdownDescription: "The number of packets which could not be transmitted because of errors.",
+									Computed:            true,
+								},
+								"in_discards": schema.Int64Attribute{
+									MarkdownDescription: "The number of received packets which were discarded.",
+									Computed:            true,
+								},
+								"out_discards": schema.Int64Attribute{
+									MarkdownDescription: "The number of packets to transmit which were discarded.",
+									Computed:            true,
+								},
+								"crc_errors": schema.Int64Attribute{
+									MarkdownDescription: "The number of received packets with a CRC error.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_port_status")
+}
+
+func (d *NodePortStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_node_port_status")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_node_port_status")
+}
+
+func (d *NodePortStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_node_port_status")
+	var data *NodePortStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/ports/status", data.NodeId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_node_port_status with id '%s'", data.Id.ValueString()))
+
+	names := []string{}
+	resp.Diagnostics.Append(data.Names.ElementsAs(ctx, &names, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration of the ports is looked up by name to retrieve their id before their status
+	configuredPorts := make([]NodePortSummaryDataSourceModel, 0)
+	foundNames := []string{}
+	for _, port := range getNodePortsList(ctx, &resp.Diagnostics, d.client, data.NodeId.ValueString(), ObjectFilters{}) {
+		if ContainsString(names, port.Name.ValueString()) {
+			configuredPorts = append(configuredPorts, port)
+			foundNames = append(foundNames, port.Name.ValueString())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	missingNames := []string{}
+	for _, name := range names {
+		if !ContainsString(foundNames, name) {
+			missingNames = append(missingNames, name)
+		}
+	}
+	if len(missingNames) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("names"),
+			"Port Not Found",
+			fmt.Sprintf("The ports '%s' have not been found on the Node '%s'", strings.Join(missingNames, "', '"), data.NodeId.ValueString()),
+		)
+		return
+	}
+
+	allUp := true
+	ports := make([]NodePortStatusPortDataSourceModel, 0)
+	for _, configuredPort := range configuredPorts {
+		port := getNodePortStatus(ctx, &resp.Diagnostics, d.client, configuredPort)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		allUp = allUp && port.Up.ValueBool()
+		ports = append(ports, port)
+	}
+	data.AllUp = basetypes.NewBoolValue(allUp)
+	data.Ports, _ = types.ListValueFrom(ctx, NodePortStatusPortDataSourceModelAttributeType(), ports)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_node_port_status with id '%s'", data.Id.ValueString()))
+}
+
+func getNodePortStatus(ctx context.Context, diags *diag.Diagnostics, client *client.Client, configuredPort NodePortSummaryDataSourceModel) NodePortStatusPortDataSourceModel {
+	port := NodePortStatusPortDataSourceModel{
+		Id:              configuredPort.Id,
+		PortId:          configuredPort.PortId,
+		Name:            configuredPort.Name,
+		Enabled:         configuredPort.Enabled,
+		Speed:           configuredPort.Speed,
+		MaxSpeed:        configuredPort.MaxSpeed,
+		Mtu:             configuredPort.Mtu,
+		OperState:       basetypes.NewStringNull(),
+		Up:              basetypes.NewBoolValue(false),
+		NegotiatedSpeed: basetypes.NewStringNull(),
+		LastFlap:        basetypes.NewStringNull(),
+		FlapCount:       basetypes.NewInt64Null(),
+		Transceiver:     basetypes.NewObjectNull(NodePortStatusTransceiverDataSourceModelAttributeType().AttrTypes),
+		Counters:        basetypes.NewObjectNull(NodePortStatusCountersDataSourceModelAttributeType().AttrTypes),
+	}
+
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/status", configuredPort.Id.ValueString()), "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return port
+	}
+
+	attributes, ok := requestData.Data().(map[string]interface{})
+	if !ok {
+		return port
+	}
+
+	if operState := getStringFromMap(attributes, "operState"); operState != "" {
+		port.OperState = basetypes.NewStringValue(operState)
+		port.Up = basetypes.NewBoolValue(operState == "UP")
+	}
+	if negotiatedSpeed := getStringFromMap(attributes, "speed"); negotiatedSpeed != "" {
+		port.NegotiatedSpeed = basetypes.NewStringValue(negotiatedSpeed)
+	}
+	if lastFlap := getStringFromMap(attributes, "lastFlap"); lastFlap != "" {
+		port.LastFlap = basetypes.NewStringValue(lastFlap)
+	}
+	port.FlapCount = getNodePortStatusCounter(attributes, "flapCount")
+
+	if transceiver, ok := attributes["transceiver"].(map[string]interface{}); ok {
+		port.Transceiver, _ = types.ObjectValueFrom(ctx, NodePortStatusTransceiverDataSourceModelAttributeType().AttrTypes, NodePortStatusTransceiverDataSourceModel{
+			Type:         basetypes.NewStringValue(getStringFromMap(transceiver, "type")),
+			Vendor:       basetypes.NewStringValue(getStringFromMap(transceiver, "vendor")),
+			PartNumber:   basetypes.NewStringValue(getStringFromMap(transceiver, "partNumber")),
+			SerialNumber: basetypes.NewStringValue(getStringFromMap(transceiver, "serialNumber")),
+			Temperature:  getNodePortStatusReading(transceiver, "temperature"),
+			Voltage:      getNodePortStatusReading(transceiver, "voltage"),
+			TxPower:      getNodePortStatusReading(transceiver, "txPower"),
+			RxPower:      getNodePortStatusReading(transceiver, "rxPower"),
+		})
+	}
+
+	if counters, ok := attributes["counters"].(map[string]interface{}); ok {
+		port.Counters, _ = types.ObjectValueFrom(ctx, NodePortStatusCountersDataSourceModelAttributeType().AttrTypes, NodePortStatusCountersDataSourceModel{
+			InErrors:    getNodePortStatusCounter(counters, "inErrors"),
+			OutErrors:   getNodePortStatusCounter(counters, "outErrors"),
+			InDiscards:  getNodePortStatusCounter(counters, "inDiscards"),
+			OutDiscards: getNodePortStatusCounter(counters, "outDiscards"),
+			CrcErrors:   getNodePortStatusCounter(counters, "crcErrors"),
+		})
+	}
+	return port
+}
+
+// getNodePortStatusReading returns a DOM reading of a transceiver, or null when the transceiver does not report it.
+func getNodePortStatusReading(transceiver map[string]interface{}, key string) basetypes.Float64Value {
+	if value, ok := transceiver[key].(float64); ok {
+		return basetypes.NewFloat64Value(value)
+	}
+	return basetypes.NewFloat64Null()
+}
+
+// getNodePortStatusCounter returns a counter of the status of a Port, or null when the status does not report it.
+func getNodePortStatusCounter(attributes map[string]interface{}, key string) basetypes.Int64Value {
+	if value, ok := attributes[key].(float64); ok {
+		return basetypes.NewInt64Value(int64(value))
+	}
+	return basetypes.NewInt64Null()
+}
@@ -0,0 +1,275 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodePortsDataSource{}
+
+func NewNodePortsDataSource() datasource.DataSource {
+	return &NodePortsDataSource{}
+}
+
+// NodePortsDataSource defines the data source implementation.
+type NodePortsDataSource struct {
+	client *client.Client
+}
+
+// NodePortsDataSourceModel describes the data source data model.
+type NodePortsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	NodeId      types.String `tfsdk:"node_id"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	Roles       types.Set    `tfsdk:"roles"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Ports       types.List   `tfsdk:"ports"`
+}
+
+// NodePortSummaryDataSourceModel describes a Port in the list of Ports of a Node.
+type NodePortSummaryDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	PortId      types.String `tfsdk:"port_id"`
+	NodeId      types.String `tfsdk:"node_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Index       types.Int64  `tfsdk:"index"`
+	Linecard    types.Int64  `tfsdk:"linecard"`
+	Speed       types.String `tfsdk:"speed"`
+	MaxSpeed    types.String `tfsdk:"max_speed"`
+	Mtu         types.Int64  `tfsdk:"mtu"`
+	Roles       types.Set    `tfsdk:"roles"`
+	VrfId       types.String `tfsdk:"vrf_id"`
+	LldpHost    types.String `tfsdk:"lldp_host"`
+	LldpPort    types.String `tfsdk:"lldp_port"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Set    `tfsdk:"annotations"`
+}
+
+func NodePortSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":          types.StringType,
+			"port_id":     types.StringType,
+			"node_id":     types.StringType,
+			"name":        types.StringType,
+			"description": types.StringType,
+			"enabled":     types.BoolType,
+			"index":       types.Int64Type,
+			"linecard":    types.Int64Type,
+			"speed":       types.StringType,
+			"max_speed":   types.StringType,
+			"mtu":         types.Int64Type,
+			"roles":       types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"vrf_id":      types.StringType,
+			"lldp_host":   types.StringType,
+			"lldp_port":   types.StringType,
+			"labels":      types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"annotations": types.SetType{ElemType: AnnotationResourceModelAttributeType()},
+		},
+	}
+}
+
+func (d *NodePortsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_node_ports")
+	resp.TypeName = req.ProviderTypeName + "_node_ports"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_node_ports")
+}
+
+func (d *NodePortsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_node_ports")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Node Ports data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `node_id` followed by `/ports`.",
+				Computed:            true,
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "`node_id` defines the unique identifier of a Node in a Fabric.",
+				Required:            true,
+			},
+			"name_regex":  getNameRegexFilterSchemaAttribute("Ports"),
+			"labels":      getLabelsFilterSchemaAttribute("Ports"),
+			"annotations": getAnnotationsFilterSchemaAttribute("Ports"),
+			"roles":       getRolesFilterSchemaAttribute("Ports", []string{"UNUSED_PORT", "FABRIC_PORT", "HOST_PORT", "ROUTED_PORT", "LAG_PORT"}),
+			"enabled":     getEnabledFilterSchemaAttribute("Ports"),
+			"ports": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Ports of the Node matching the filters sorted by linecard and index.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Port of a Node in a Fabric.",
+							Computed:            true,
+						},
+						"port_id": schema.StringAttribute{
+							MarkdownDescription: "`port_id` defines the unique identifier of a Port.",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "`node_id` defines the unique identifier of a Node in a Fabric.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Port of the Node.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the Port of the Node.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The enabled admin state of the Port of the Node.",
+							Computed:            true,
+						},
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "The index number of the Port of the Node.",
+							Computed:            true,
+						},
+						"linecard": schema.Int64Attribute{
+							MarkdownDescription: "The linecard index number of the Port of the Node.",
+							Computed:            true,
+						},
+						"speed": schema.StringAttribute{
+							MarkdownDescription: "The configured speed of the Port of the Node.",
+							Computed:            true,
+						},
+						"max_speed": schema.StringAttribute{
+							MarkdownDescription: "The maximum speed of the Port of the Node.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "The MTU of the Port of the Node.",
+							Computed:            true,
+						},
+						"roles": getPortRolesDataSourceSchemaAttribute(),
+						"vrf_id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the VRF associated with the Port of the Node.",
+							Computed:            true,
+						},
+						"lldp_host": schema.StringAttribute{
+							MarkdownDescription: "The name of the host reported by LLDP connected to the Port of the Node.",
+							Computed:            true,
+						},
+						"lldp_port": schema.StringAttribute{
+							MarkdownDescription: "The name of the port reported by LLDP connected to the Port of the Node.",
+							Computed:            true,
+						},
+						"labels":      getLabelsDataSourceSchemaAttribute(),
+						"annotations": getAnnotationsDataSourceSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_ports")
+}
+
+func (d *NodePortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_node_ports")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_node_ports")
+}
+
+func (d *NodePortsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_node_ports")
+	var data *NodePortsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/ports", data.NodeId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+
+	filters := getObjectFilters(ctx, data.NameRegex, data.Labels, data.Annotations, data.Roles, data.Enabled)
+	ports := getNodePortsList(ctx, &resp.Diagnostics, d.client, data.NodeId.ValueString(), filters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Ports, _ = types.ListValueFrom(ctx, NodePortSummaryDataSourceModelAttributeType(), ports)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+}
+
+// getNodePortsList returns the Ports of the Node matching the filters sorted by linecard and index.
+func getNodePortsList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, nodeId string, filters ObjectFilters) []NodePortSummaryDataSourceModel {
+	ports := make([]NodePortSummaryDataSourceModel, 0)
+	for _, port := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/ports", nodeId), "ports") {
+		if !filters.matches(port, "name") {
+			continue
+		}
+
+		roles, _ := port["roles"].([]interface{})
+		ports = append(ports, NodePortSummaryDataSourceModel{
+			Id:          basetypes.NewStringValue(fmt.Sprintf("%s/ports/%s", nodeId, getStringFromMap(port, "id"))),
+			PortId:      basetypes.NewStringValue(getStringFromMap(port, "id")),
+			NodeId:      basetypes.NewStringValue(nodeId),
+			Name:        basetypes.NewStringValue(getStringFromMap(port, "name")),
+			Description: basetypes.NewStringValue(getStringFromMap(port, "description")),
+			Enabled:     basetypes.NewBoolValue(port["enabled"] == true),
+			Index:       basetypes.NewInt64Value(getInt64FromMap(port, "index")),
+			Linecard:    basetypes.NewInt64Value(getInt64FromMap(port, "linecard")),
+			Speed:       basetypes.NewStringValue(getStringFromMap(port, "speed")),
+			MaxSpeed:    basetypes.NewStringValue(getStringFromMap(port, "maxSpeed")),
+			Mtu:         basetypes.NewInt64Value(getInt64FromMap(port, "mtu")),
+			Roles:       NewSetString(ctx, roles),
+			VrfId:       basetypes.NewStringValue(getStringFromMap(port, "vrfId")),
+			LldpHost:    basetypes.NewStringValue(getStringFromMap(port, "lldpHost")),
+			LldpPort:    basetypes.NewStringValue(getStringFromMap(port, "lldpPort")),
+			Labels:      getObjectLabelsSet(ctx, port),
+			Annotations: getObjectAnnotationsSet(ctx, port),
+		})
+	}
+
+	sort.SliceStable(ports, func(i, j int) bool {
+		if ports[i].Linecard.ValueInt64() != ports[j].Linecard.ValueInt64() {
+			return ports[i].Linecard.ValueInt64() < ports[j].Linecard.ValueInt64()
+		}
+		return ports[i].Index.ValueInt64() < ports[j].Index.ValueInt64()
+	})
+	return ports
+}
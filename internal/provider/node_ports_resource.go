@@ -0,0 +1,676 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	customTypes "github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/provider/custom_types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodePortsResource{}
+var _ resource.ResourceWithImportState = &NodePortsResource{}
+var _ resource.ResourceWithModifyPlan = &NodePortsResource{}
+var _ resource.ResourceWithMoveState = &NodePortsResource{}
+
+func NewNodePortsResource() resource.Resource {
+	return &NodePortsResource{}
+}
+
+// NodePortsResource defines the resource implementation.
+type NodePortsResource struct {
+	client *client.Client
+}
+
+// NodePortsResourceModel describes the resource data model.
+type NodePortsResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	NodeId               types.String `tfsdk:"node_id"`
+	Ports                types.Map    `tfsdk:"ports"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
+}
+
+// NodePortsPortResourceModel describes the settings of a Port in the map of Ports of the resource.
+type NodePortsPortResourceModel struct {
+	PortId            types.String                      `tfsdk:"port_id"`
+	Description       types.String                      `tfsdk:"description"`
+	Enabled           types.Bool                        `tfsdk:"enabled"`
+	Ipv4Addresses     types.Set                         `tfsdk:"ipv4_addresses"`
+	Ipv6Addresses     types.Set                         `tfsdk:"ipv6_addresses"`
+	PreventForwarding types.Bool                        `tfsdk:"prevent_forwarding"`
+	Roles             types.Set                         `tfsdk:"roles"`
+	VrfId             customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	Labels            types.Set                         `tfsdk:"labels"`
+	Annotations       types.Set                         `tfsdk:"annotations"`
+}
+
+func NodePortsPortResourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"port_id":            types.StringType,
+			"description":        types.StringType,
+			"enabled":            types.BoolType,
+			"ipv4_addresses":     types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"ipv6_addresses":     types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"prevent_forwarding": types.BoolType,
+			"roles":              types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"vrf_id":             customTypes.UuidFromIdStringType{},
+			"labels":             types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"annotations":        types.SetType{ElemType: AnnotationResourceModelAttributeType()},
+		},
+	}
+}
+
+// getNodePortFromNodePortsPort returns the Node Port model of the Port with the provided name, so the payload and
+// attributes of the Port are handled the same way as for the hyperfabric_node_port resource.
+func getNodePortFromNodePortsPort(data *NodePortsResourceModel, name string, port NodePortsPortResourceModel) *NodePortResourceModel {
+	nodePort := getEmptyNodePortResourceModel()
+	nodePort.NodeId = data.NodeId
+	nodePort.Name = basetypes.NewStringValue(name)
+	nodePort.PortId = port.PortId
+	if !port.PortId.IsNull() && !port.PortId.IsUnknown() {
+		nodePort.Id = basetypes.NewStringValue(fmt.Sprintf("%s/ports/%s", data.NodeId.ValueString(), port.PortId.ValueString()))
+	}
+	nodePort.Description = port.Description
+	nodePort.Enabled = port.Enabled
+	nodePort.Ipv4Addresses = port.Ipv4Addresses
+	nodePort.Ipv6Addresses = port.Ipv6Addresses
+	nodePort.PreventForwarding = port.PreventForwarding
+	nodePort.Roles = port.Roles
+	nodePort.VrfId = port.VrfId
+	nodePort.Labels = port.Labels
+	nodePort.Annotations = port.Annotations
+	nodePort.IgnoreExternalLabels = data.IgnoreExternalLabels
+	return nodePort
+}
+
+func getNodePortsPortFromNodePort(nodePort *NodePortResourceModel) NodePortsPortResourceModel {
+	return NodePortsPortResourceModel{
+		PortId:            nodePort.PortId,
+		Description:       nodePort.Description,
+		Enabled:           nodePort.Enabled,
+		Ipv4Addresses:     nodePort.Ipv4Addresses,
+		Ipv6Addresses:     nodePort.Ipv6Addresses,
+		PreventForwarding: nodePort.PreventForwarding,
+		Roles:             nodePort.Roles,
+		VrfId:             nodePort.VrfId,
+		Labels:            nodePort.Labels,
+		Annotations:       nodePort.Annotations,
+	}
+}
+
+func (r *NodePortsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *NodePortsResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		if resp.Diagnostics.HasError() || planData.Ports.IsUnknown() {
+			return
+		}
+
+		// Validate the names against the model of the Node only for the Ports that are planned to be added
+		portNames := make([]string, 0)
+		for name := range planData.Ports.Elements() {
+			if stateData == nil || stateData.NodeId.ValueString() != planData.NodeId.ValueString() {
+				portNames = append(portNames, name)
+			} else if _, ok := stateData.Ports.Elements()[name]; !ok {
+				portNames = append(portNames, name)
+			}
+		}
+		sort.Strings(portNames)
+		checkPortNamesForNodeModel(ctx, &resp.Diagnostics, r.client, planData.NodeId, path.Root("ports"), portNames, false)
+	}
+}
+
+func (r *NodePortsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_node_ports")
+	resp.TypeName = req.ProviderTypeName + "_node_ports"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_node_ports")
+}
+
+func (r *NodePortsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_node_ports")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Node Ports resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `node_id` of the Node.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: "`node_id` defines the unique identifier of a Node in a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ports": schema.MapNestedAttribute{
+				MarkdownDescription: "A map of Port names to the settings of the Ports of the Node.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(IsPortName()),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port_id": schema.StringAttribute{
+							MarkdownDescription: "`port_id` defines the unique identifier of a Port of a Node.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the Port of the Node.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The enabled admin state of the Port of the Node.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ipv4_addresses": schema.SetAttribute{
+							MarkdownDescription: `A set of IPv4 addresses to be configured on the Port of the Node.`,
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(IsIpv4Cidr()),
+								setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("vrf_id")),
+							},
+							ElementType: types.StringType,
+						},
+						"ipv6_addresses": schema.SetAttribute{
+							MarkdownDescription: `A set of IPv6 addresses to be configured on the Port of the Node.`,
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(IsIpv6Cidr()),
+								setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("vrf_id")),
+							},
+							ElementType: types.StringType,
+						},
+						"prevent_forwarding": schema.BoolAttribute{
+							MarkdownDescription: "Prevent traffic from being forwarded by the Port.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"roles": schema.SetAttribute{
+							MarkdownDescription: `A set of roles used for the Port of the Node.`,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf([]string{"UNUSED_PORT", "FABRIC_PORT", "HOST_PORT", "ROUTED_PORT", "LAG_PORT"}...)),
+							},
+							ElementType: types.StringType,
+						},
+						"vrf_id": schema.StringAttribute{
+							CustomType:          customTypes.UuidFromIdStringType{},
+							MarkdownDescription: "The `vrf_id` of a VRF to associate with the Port of the Node. Required when the Port roles include `ROUTED_PORT`.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								CompareUuidWithIdForEquality(),
+							},
+						},
+						"labels":      getLabelsSchemaAttribute(),
+						"annotations": getAnnotationsSchemaAttribute(),
+					},
+				},
+			},
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_node_ports")
+}
+
+func (r *NodePortsResource) MoveState(ctx context.Context) []resource.StateMover {
+	var nodePortSchema resource.SchemaResponse
+	(&NodePortResource{}).Schema(ctx, resource.SchemaRequest{}, &nodePortSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &nodePortSchema.Schema,
+			StateMover:   moveNodePortStateToNodePorts,
+		},
+	}
+}
+
+// moveNodePortStateToNodePorts moves the state of a hyperfabric_node_port resource into a hyperfabric_node_ports
+// resource managing only that Port. The other Ports of the Node can then be added to the map of Ports.
+func moveNodePortStateToNodePorts(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	// Only the type of the provider is compared as the namespace differs between the registry and local builds
+	providerType := req.SourceProviderAddress[strings.LastIndex(req.SourceProviderAddress, "/")+1:]
+	if req.SourceTypeName != "hyperfabric_node_port" || !strings.EqualFold(providerType, "hyperfabric") {
+		return
+	}
+
+	if req.SourceSchemaVersion != 1 {
+		resp.Diagnostics.AddError(
+			"Unsupported hyperfabric_node_port state version",
+			fmt.Sprintf("The state of the hyperfabric_node_port resource with version %d cannot be moved. Run 'terraform apply' with the current version of the provider before moving the resource.", req.SourceSchemaVersion),
+		)
+		return
+	}
+
+	var sourceData *NodePortResourceModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &sourceData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkAndSetNodePortIds(sourceData)
+	tflog.Debug(ctx, fmt.Sprintf("Move of resource hyperfabric_node_port with id '%s' to hyperfabric_node_ports", sourceData.Id.ValueString()))
+
+	ports := map[string]NodePortsPortResourceModel{
+		sourceData.Name.ValueString(): getNodePortsPortFromNodePort(sourceData),
+	}
+	targetData := &NodePortsResourceModel{
+		Id:                   sourceData.NodeId,
+		NodeId:               sourceData.NodeId,
+		IgnoreExternalLabels: sourceData.IgnoreExternalLabels,
+	}
+	var diags diag.Diagnostics
+	targetData.Ports, diags = types.MapValueFrom(ctx, NodePortsPortResourceModelAttributeType(), ports)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &targetData)...)
+}
+
+func (r *NodePortsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_node_ports")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_node_ports")
+}
+
+func (r *NodePortsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_node_ports")
+
+	var data *NodePortsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_node_ports with node_id '%s'", data.NodeId.ValueString()))
+
+	data.Id = data.NodeId
+	applyNodePortsChanges(ctx, &resp.Diagnostics, r.client, data, nil)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodePortsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_node_ports")
+	var data *NodePortsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+	if data.NodeId.IsNull() || data.NodeId.IsUnknown() {
+		data.NodeId = data.Id
+	}
+	getAndSetNodePortsAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *NodePortsResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodePortsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_node_ports")
+	var data *NodePortsResourceModel
+	var stateData *NodePortsResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+
+	applyNodePortsChanges(ctx, &resp.Diagnostics, r.client, data, stateData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodePortsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_node_ports")
+	var data *NodePortsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+
+	emptyData := &NodePortsResourceModel{
+		Id:                   data.Id,
+		NodeId:               data.NodeId,
+		Ports:                basetypes.NewMapValueMust(NodePortsPortResourceModelAttributeType(), map[string]attr.Value{}),
+		IgnoreExternalLabels: data.IgnoreExternalLabels,
+	}
+	applyNodePortsChanges(ctx, &resp.Diagnostics, r.client, emptyData, data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_node_ports with id '%s'", data.Id.ValueString()))
+}
+
+func (r *NodePortsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_node_ports")
+	node := getEmptyNodeResourceModel()
+	node.Id = basetypes.NewStringValue(req.ID)
+	checkAndSetNodeIds(node)
+	newFabric := getEmptyFabricResourceModel()
+	newFabric.Id = node.FabricId
+	getAndSetFabricAttributes(ctx, &resp.Diagnostics, r.client, newFabric)
+	node.FabricId = newFabric.Id
+	req.ID = node.FabricId.ValueString() + "/nodes/" + node.NodeId.ValueString()
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *NodePortsResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_node_ports with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_node_ports")
+}
+
+// getNodePortsAttributesMap retrieves all the Ports of the Node in a single request and returns their attributes
+// by Port name. False is returned when the Node does not exist.
+func getNodePortsAttributesMap(ctx context.Context, diags *diag.Diagnostics, client *client.Client, nodeId string) (map[string]map[string]interface{}, bool) {
+	ports := map[string]map[string]interface{}{}
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/ports", nodeId), "GET", nil)
+	if diags.HasError() || requestData == nil || requestData.Data() == nil {
+		return ports, false
+	}
+
+	if responseMap, ok := requestData.Data().(map[string]interface{}); ok {
+		if list, ok := responseMap["ports"].([]interface{}); ok {
+			for _, port := range list {
+				if portMap, ok := port.(map[string]interface{}); ok && getStringFromMap(portMap, "name") != "" {
+					ports[getStringFromMap(portMap, "name")] = portMap
+				}
+			}
+		}
+	}
+	return ports, true
+}
+
+// getAndSetNodePortsAttributes refreshes the Ports found in the data. When the data does not contain any Port, such
+// as after an import, all the Ports of the Node are added to the data.
+func getAndSetNodePortsAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *NodePortsResourceModel) {
+	portsAttributes, found := getNodePortsAttributesMap(ctx, diags, client, data.NodeId.ValueString())
+	if diags.HasError() {
+		return
+	}
+
+	if !found {
+		data.Id = basetypes.NewStringNull()
+		return
+	}
+
+	ports := map[string]NodePortsPortResourceModel{}
+	if !data.Ports.IsNull() && !data.Ports.IsUnknown() {
+		diags.Append(data.Ports.ElementsAs(ctx, &ports, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	importAll := len(ports) == 0
+	if importAll {
+		for name := range portsAttributes {
+			ports[name] = NodePortsPortResourceModel{
+				PortId:        basetypes.NewStringNull(),
+				Ipv4Addresses: basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+				Ipv6Addresses: basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+				Roles:         basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+				VrfId:         customTypes.NewUuidFromIdStringNull(),
+				Labels:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+				Annotations:   basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+			}
+		}
+	}
+
+	newPorts := map[string]NodePortsPortResourceModel{}
+	for name, port := range ports {
+		attributes, ok := portsAttributes[name]
+		if !ok {
+			tflog.Debug(ctx, fmt.Sprintf("Port '%s' of Node '%s' not found, removing it from the state", name, data.NodeId.ValueString()))
+			continue
+		}
+		nodePort := getNodePortFromNodePortsPort(data, name, port)
+		setNodePortAttributes(ctx, nodePort, attributes)
+		// Use the Node id returned by the API so an id using names is replaced by the id using identifiers
+		data.NodeId = nodePort.NodeId
+		newPorts[name] = getNodePortsPortFromNodePort(nodePort)
+	}
+
+	data.Id = data.NodeId
+	var mapDiags diag.Diagnostics
+	data.Ports, mapDiags = types.MapValueFrom(ctx, NodePortsPortResourceModelAttributeType(), newPorts)
+	diags.Append(mapDiags...)
+}
+
+// applyNodePortsChanges compares the planned Ports with the Ports found in the state, resets the removed Ports and
+// sends a request only for the Ports that are added or changed. All the Ports are retrieved in a single request
+// before and after the changes. The Ports that are not changed are kept as planned. When a request fails, only the
+// Ports changed or reset before the failure are written to data.
+func applyNodePortsChanges(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data, stateData *NodePortsResourceModel) {
+	planPorts := map[string]NodePortsPortResourceModel{}
+	diags.Append(data.Ports.ElementsAs(ctx, &planPorts, false)...)
+	statePorts := map[string]NodePortsPortResourceModel{}
+	stateElements := map[string]attr.Value{}
+	if stateData != nil && !stateData.Ports.IsNull() {
+		diags.Append(stateData.Ports.ElementsAs(ctx, &statePorts, false)...)
+		stateElements = stateData.Ports.Elements()
+	}
+	if diags.HasError() {
+		return
+	}
+
+	appliedPorts := map[string]NodePortsPortResourceModel{}
+	for name, statePort := range statePorts {
+		appliedPorts[name] = statePort
+	}
+	updatedPorts := make([]string, 0)
+	defer func() {
+		if !diags.HasError() {
+			return
+		}
+		// Refresh the Ports updated before the failure, the state of the other Ports is kept
+		var refreshDiags diag.Diagnostics
+		portsAttributes, _ := getNodePortsAttributesMap(ctx, &refreshDiags, client, data.NodeId.ValueString())
+		for _, name := range updatedPorts {
+			if attributes, ok := portsAttributes[name]; ok {
+				nodePort := getNodePortFromNodePortsPort(data, name, planPorts[name])
+				setNodePortAttributes(ctx, nodePort, attributes)
+				appliedPorts[name] = getNodePortsPortFromNodePort(nodePort)
+			}
+		}
+		data.Ports, _ = types.MapValueFrom(ctx, NodePortsPortResourceModelAttributeType(), appliedPorts)
+	}()
+
+	removedPorts := make([]string, 0)
+	for name := range statePorts {
+		if _, ok := planPorts[name]; !ok {
+			removedPorts = append(removedPorts, name)
+		}
+	}
+	sort.Strings(removedPorts)
+
+	changedPorts := make([]string, 0)
+	for name, planPort := range data.Ports.Elements() {
+		if statePort, ok := stateElements[name]; !ok || !planPort.Equal(statePort) {
+			changedPorts = append(changedPorts, name)
+		}
+	}
+	sort.Strings(changedPorts)
+
+	if len(removedPorts) == 0 && len(changedPorts) == 0 {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Changing Ports %v and resetting Ports %v of Node '%s'", changedPorts, removedPorts, data.NodeId.ValueString()))
+	portsAttributes, _ := getNodePortsAttributesMap(ctx, diags, client, data.NodeId.ValueString())
+	if diags.HasError() {
+		return
+	}
+
+	for _, name := range removedPorts {
+		portPath := fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), name)
+		if attributes, ok := portsAttributes[name]; ok {
+			checkObjectAttributesOwnership(diags, attributes, portPath, "hyperfabric_node_ports", "delete")
+			if diags.HasError() {
+				return
+			}
+		}
+
+		resetNodePort(ctx, diags, client, getNodePortFromNodePortsPort(data, name, statePorts[name]))
+		if diags.HasError() {
+			return
+		}
+		delete(appliedPorts, name)
+	}
+
+	for _, name := range changedPorts {
+		portPath := fmt.Sprintf("/api/v1/fabrics/%s/ports/%s", data.NodeId.ValueString(), name)
+		nodePort := getNodePortFromNodePortsPort(data, name, planPorts[name])
+		jsonPayload := getNodePortJsonPayload(ctx, diags, nodePort, "update")
+		if diags.HasError() {
+			return
+		}
+
+		stateLabels := basetypes.NewSetNull(SetStringResourceModelAttributeType())
+		stateAnnotations := basetypes.NewSetNull(AnnotationResourceModelAttributeType())
+		if statePort, ok := statePorts[name]; ok {
+			stateLabels = statePort.Labels
+			stateAnnotations = statePort.Annotations
+		}
+
+		if attributes, ok := portsAttributes[name]; ok {
+			action := "update"
+			if _, ok := statePorts[name]; !ok {
+				action = "create"
+			}
+			checkObjectAttributesOwnership(diags, attributes, portPath, "hyperfabric_node_ports", action)
+			if diags.HasError() {
+				return
+			}
+			preserveExternalLabelsAndAnnotationsFromAttributes(ctx, portPath, jsonPayload, attributes, stateLabels, stateAnnotations, data.IgnoreExternalLabels)
+		}
+
+		DoRestRequest(ctx, diags, client, portPath, "PUT", jsonPayload)
+		if diags.HasError() {
+			return
+		}
+		updatedPorts = append(updatedPorts, name)
+	}
+
+	portsAttributes, _ = getNodePortsAttributesMap(ctx, diags, client, data.NodeId.ValueString())
+	if diags.HasError() {
+		return
+	}
+
+	for _, name := range changedPorts {
+		nodePort := getNodePortFromNodePortsPort(data, name, planPorts[name])
+		setNodePortAttributes(ctx, nodePort, portsAttributes[name])
+		if nodePort.Id.IsNull() {
+			diags.AddError(
+				"Port not found after update",
+				fmt.Sprintf("The Port '%s' of the Node '%s' could not be retrieved after it was updated.", name, data.NodeId.ValueString()),
+			)
+			return
+		}
+		planPorts[name] = getNodePortsPortFromNodePort(nodePort)
+	}
+
+	var mapDiags diag.Diagnostics
+	data.Ports, mapDiags = types.MapValueFrom(ctx, NodePortsPortResourceModelAttributeType(), planPorts)
+	diags.Append(mapDiags...)
+}
@@ -0,0 +1,292 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNodePortsResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that invalid Port names are rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Validate that invalid Port names are rejected during plan.")
+				},
+				Config:      testNodePortsResourceHclConfig(fabricName, "invalid"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match|Invalid Port Name`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hyperfabric_node_ports.test", "id", "hyperfabric_node.test", "id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.%", "2"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_ports.test", "ports.Ethernet1_1.port_id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.roles.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.roles.0", "ROUTED_PORT"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_2.roles.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_2.roles.0", "HOST_PORT"),
+				),
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Update with all config and verify provided values.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.%", "3"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.description", "Connected to server01"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.enabled", "true"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.ipv4_addresses.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.ipv4_addresses.0", "10.1.0.1/24"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.ipv6_addresses.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.ipv6_addresses.0", "2001:1::1/64"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.prevent_forwarding", "true"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_ports.test", "ports.Ethernet1_1.vrf_id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.labels.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.labels.0", "server01"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_2.description", "Connected to server02"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_2.roles.0", "HOST_PORT"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_3.roles.0", "FABRIC_PORT"),
+				),
+			},
+			// Run Plan Only with full config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Run Plan Only with full config and check that plan is empty.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Update with minimum config and verify the removed Port is no longer managed.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Update with minimum config and verify the removed Port is no longer managed.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.%", "2"),
+					resource.TestCheckNoResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_3.port_id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.description", "Connected to server01"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_2.description", "Connected to server02"),
+				),
+			},
+			// Update with config containing all optional attributes with empty values and verify config is cleared.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Update with config containing all optional attributes with empty values and verify config is cleared.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "clear"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.%", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.description", ""),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.ipv4_addresses.#", "0"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.ipv6_addresses.#", "0"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.prevent_forwarding", "false"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.vrf_id", ""),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.labels.#", "0"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_2.description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNodePortsResourceMoveState(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Moving the state between resource types is only available in Terraform 1.8 and later.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create a Port with the hyperfabric_node_port resource.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Create a Port with the hyperfabric_node_port resource.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "move-source"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_port.test", "name", "Ethernet1_1"),
+					resource.TestCheckResourceAttr("hyperfabric_node_port.test", "description", "Connected to server01"),
+				),
+			},
+			// Move the hyperfabric_node_port resource to the hyperfabric_node_ports resource and verify values are kept.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - Move the hyperfabric_node_port resource to the hyperfabric_node_ports resource and verify values are kept.")
+				},
+				Config:             testNodePortsResourceHclConfig(fabricName, "move-target"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.%", "1"),
+					resource.TestCheckResourceAttrSet("hyperfabric_node_ports.test", "ports.Ethernet1_1.port_id"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.description", "Connected to server01"),
+					resource.TestCheckResourceAttr("hyperfabric_node_ports.test", "ports.Ethernet1_1.roles.0", "HOST_PORT"),
+				),
+			},
+			// ImportState testing with fabric and node name.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Node Ports - ImportState testing with fabric and node name.")
+				},
+				ResourceName:  "hyperfabric_node_ports.test",
+				ImportState:   true,
+				ImportStateId: fabricName + "/nodes/node1",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["ports.Ethernet1_1.description"] != "Connected to server01" {
+						return fmt.Errorf("expected the imported state to contain the Port Ethernet1_1 with its description")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testNodePortsResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_vrf" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vrf1"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+`, fabricName)
+
+	if configType == "full" {
+		return base + `
+resource "hyperfabric_node_ports" "test" {
+	node_id = hyperfabric_node.test.id
+	ports = {
+		Ethernet1_1 = {
+			description        = "Connected to server01"
+			enabled            = true
+			ipv4_addresses     = ["10.1.0.1/24"]
+			ipv6_addresses     = ["2001:1::1/64"]
+			prevent_forwarding = true
+			roles              = ["ROUTED_PORT"]
+			vrf_id             = hyperfabric_vrf.test.vrf_id
+			labels             = ["server01"]
+		}
+		Ethernet1_2 = {
+			description = "Connected to server02"
+			roles       = ["HOST_PORT"]
+		}
+		Ethernet1_3 = {
+			roles = ["FABRIC_PORT"]
+		}
+	}
+}
+`
+	} else if configType == "clear" {
+		return base + `
+resource "hyperfabric_node_ports" "test" {
+	node_id = hyperfabric_node.test.id
+	ports = {
+		Ethernet1_1 = {
+			description        = ""
+			ipv4_addresses     = []
+			ipv6_addresses     = []
+			prevent_forwarding = false
+			roles              = ["ROUTED_PORT"]
+			vrf_id             = ""
+			labels             = []
+		}
+		Ethernet1_2 = {
+			description = ""
+			roles       = ["HOST_PORT"]
+		}
+	}
+}
+`
+	} else if configType == "invalid" {
+		return base + `
+resource "hyperfabric_node_ports" "test" {
+	node_id = hyperfabric_node.test.id
+	ports = {
+		"Eth1/1" = {
+			roles = ["ROUTED_PORT"]
+		}
+	}
+}
+`
+	} else if configType == "move-source" {
+		return base + `
+resource "hyperfabric_node_port" "test" {
+	node_id     = hyperfabric_node.test.id
+	name        = "Ethernet1_1"
+	description = "Connected to server01"
+	roles       = ["HOST_PORT"]
+}
+`
+	} else if configType == "move-target" {
+		return base + `
+moved {
+	from = hyperfabric_node_port.test
+	to   = hyperfabric_node_ports.test
+}
+
+resource "hyperfabric_node_ports" "test" {
+	node_id = hyperfabric_node.test.id
+	ports = {
+		Ethernet1_1 = {
+			description = "Connected to server01"
+			roles       = ["HOST_PORT"]
+		}
+	}
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_node_ports" "test" {
+	node_id = hyperfabric_node.test.id
+	ports = {
+		Ethernet1_1 = {
+			roles = ["ROUTED_PORT"]
+		}
+		Ethernet1_2 = {
+			roles = ["HOST_PORT"]
+		}
+	}
+}
+`
+	}
+}
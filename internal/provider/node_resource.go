@@ -45,40 +45,42 @@ type NodeResource struct {
 
 // NodeResourceModel describes the resource data model.
 type NodeResourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	NodeId       types.String `tfsdk:"node_id"`
-	FabricId     types.String `tfsdk:"fabric_id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	Enabled      types.Bool   `tfsdk:"enabled"`
-	Location     types.String `tfsdk:"location"`
-	ModelName    types.String `tfsdk:"model_name"`
-	SerialNumber types.String `tfsdk:"serial_number"`
-	DeviceId     types.String `tfsdk:"device_id"`
-	Position     types.String `tfsdk:"position"`
-	Roles        types.Set    `tfsdk:"roles"`
-	Metadata     types.Object `tfsdk:"metadata"`
-	Labels       types.Set    `tfsdk:"labels"`
-	Annotations  types.Set    `tfsdk:"annotations"`
+	Id                   types.String `tfsdk:"id"`
+	NodeId               types.String `tfsdk:"node_id"`
+	FabricId             types.String `tfsdk:"fabric_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Location             types.String `tfsdk:"location"`
+	ModelName            types.String `tfsdk:"model_name"`
+	SerialNumber         types.String `tfsdk:"serial_number"`
+	DeviceId             types.String `tfsdk:"device_id"`
+	Position             types.String `tfsdk:"position"`
+	Roles                types.Set    `tfsdk:"roles"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyNodeResourceModel() *NodeResourceModel {
 	return &NodeResourceModel{
-		Id:           basetypes.NewStringNull(),
-		NodeId:       basetypes.NewStringNull(),
-		FabricId:     basetypes.NewStringNull(),
-		Name:         basetypes.NewStringNull(),
-		Description:  basetypes.NewStringNull(),
-		Enabled:      basetypes.NewBoolValue(false),
-		Location:     basetypes.NewStringNull(),
-		ModelName:    basetypes.NewStringNull(),
-		SerialNumber: basetypes.NewStringNull(),
-		DeviceId:     basetypes.NewStringNull(),
-		Position:     basetypes.NewStringNull(),
-		Roles:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Metadata:     basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:       basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations:  basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Id:                   basetypes.NewStringNull(),
+		NodeId:               basetypes.NewStringNull(),
+		FabricId:             basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolValue(false),
+		Location:             basetypes.NewStringNull(),
+		ModelName:            basetypes.NewStringNull(),
+		SerialNumber:         basetypes.NewStringNull(),
+		DeviceId:             basetypes.NewStringNull(),
+		Position:             basetypes.NewStringNull(),
+		Roles:                basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -145,6 +147,10 @@ func getNewNodeResourceModelFromData(data *NodeResourceModel) *NodeResourceModel
 		newNode.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newNode.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newNode
 }
 
@@ -242,10 +248,11 @@ func (r *NodeResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"roles":       getRolesSchemaAttribute(),
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"roles":                  getRolesSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_node")
@@ -384,6 +391,16 @@ func (r *NodeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s", data.FabricId.ValueString(), data.NodeId.ValueString()), "hyperfabric_node", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s", data.FabricId.ValueString(), data.NodeId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s", data.FabricId.ValueString(), data.NodeId.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -410,6 +427,11 @@ func (r *NodeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node with id '%s'", data.Id.ValueString()))
 	checkAndSetNodeIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s", data.FabricId.ValueString(), data.NodeId.ValueString()), "hyperfabric_node", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/nodes/%s", data.FabricId.ValueString(), data.NodeId.ValueString()), "DELETE", nil)
 	if resp.Diagnostics.HasError() {
 		return
@@ -472,9 +494,9 @@ func getAndSetNodeAttributes(ctx context.Context, diags *diag.Diagnostics, clien
 			} else if attributeName == "metadata" {
 				newNode.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newNode.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newNode.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newNode.Annotations = NewNodeAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newNode.Annotations = NewNodeAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 				newNode.Position = NewPositionString(ctx, attributeValue.([]interface{}))
 			}
 		}
@@ -525,7 +547,7 @@ func getNodeJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *Node
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		annotations := getAnnotationsJsonPayload(ctx, data.Annotations)
 		if !data.Position.IsNull() && !data.Position.IsUnknown() {
 			annotations = append(annotations, map[string]string{
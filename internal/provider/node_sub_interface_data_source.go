@@ -67,7 +67,7 @@ func (d *NodeSubInterfaceDataSource) Schema(ctx context.Context, req datasource.
 			},
 			"ipv4_addresses": getSubInterfaceIpv4AddressesDataSourceSchemaAttribute(),
 			"ipv6_addresses": getSubInterfaceIpv6AddressesDataSourceSchemaAttribute(),
-			"vlan_id": schema.StringAttribute{
+			"vlan_id": schema.Int64Attribute{
 				MarkdownDescription: "The VLAN ID to use as encapsulation for the Sub-Interface of the Node.",
 				Computed:            true,
 			},
@@ -79,9 +79,10 @@ func (d *NodeSubInterfaceDataSource) Schema(ctx context.Context, req datasource.
 				MarkdownDescription: "The name of the `parent` Port of the Sub-Interface of the Node.",
 				Computed:            true,
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_node_sub_interface")
@@ -15,15 +15,18 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	customTypes "github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/provider/custom_types"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -32,6 +35,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NodeSubInterfaceResource{}
 var _ resource.ResourceWithImportState = &NodeSubInterfaceResource{}
+var _ resource.ResourceWithUpgradeState = &NodeSubInterfaceResource{}
 
 func NewNodeSubInterfaceResource() resource.Resource {
 	return &NodeSubInterfaceResource{}
@@ -44,38 +48,40 @@ type NodeSubInterfaceResource struct {
 
 // NodeSubInterfaceResourceModel describes the resource data model.
 type NodeSubInterfaceResourceModel struct {
-	Id             types.String                      `tfsdk:"id"`
-	SubInterfaceId types.String                      `tfsdk:"sub_interface_id"`
-	NodeId         types.String                      `tfsdk:"node_id"`
-	Name           types.String                      `tfsdk:"name"`
-	Description    types.String                      `tfsdk:"description"`
-	Enabled        types.Bool                        `tfsdk:"enabled"`
-	Ipv4Addresses  types.Set                         `tfsdk:"ipv4_addresses"`
-	Ipv6Addresses  types.Set                         `tfsdk:"ipv6_addresses"`
-	VlanId         types.Float64                     `tfsdk:"vlan_id"`
-	VrfId          customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
-	Parent         types.String                      `tfsdk:"parent"`
-	Metadata       types.Object                      `tfsdk:"metadata"`
-	Labels         types.Set                         `tfsdk:"labels"`
-	Annotations    types.Set                         `tfsdk:"annotations"`
+	Id                   types.String                      `tfsdk:"id"`
+	SubInterfaceId       types.String                      `tfsdk:"sub_interface_id"`
+	NodeId               types.String                      `tfsdk:"node_id"`
+	Name                 types.String                      `tfsdk:"name"`
+	Description          types.String                      `tfsdk:"description"`
+	Enabled              types.Bool                        `tfsdk:"enabled"`
+	Ipv4Addresses        types.Set                         `tfsdk:"ipv4_addresses"`
+	Ipv6Addresses        types.Set                         `tfsdk:"ipv6_addresses"`
+	VlanId               types.Int64                       `tfsdk:"vlan_id"`
+	VrfId                customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	Parent               types.String                      `tfsdk:"parent"`
+	Metadata             types.Object                      `tfsdk:"metadata"`
+	Labels               types.Set                         `tfsdk:"labels"`
+	Annotations          types.Set                         `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool                        `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyNodeSubInterfaceResourceModel() *NodeSubInterfaceResourceModel {
 	return &NodeSubInterfaceResourceModel{
-		Id:             basetypes.NewStringNull(),
-		SubInterfaceId: basetypes.NewStringNull(),
-		NodeId:         basetypes.NewStringNull(),
-		Name:           basetypes.NewStringNull(),
-		Description:    basetypes.NewStringNull(),
-		Enabled:        basetypes.NewBoolValue(false),
-		Ipv4Addresses:  basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Ipv6Addresses:  basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		VlanId:         basetypes.NewFloat64Null(),
-		VrfId:          customTypes.NewUuidFromIdStringNull(),
-		Parent:         basetypes.NewStringNull(),
-		Metadata:       basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:         basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations:    basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Id:                   basetypes.NewStringNull(),
+		SubInterfaceId:       basetypes.NewStringNull(),
+		NodeId:               basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolValue(false),
+		Ipv4Addresses:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Ipv6Addresses:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		VlanId:               basetypes.NewInt64Null(),
+		VrfId:                customTypes.NewUuidFromIdStringNull(),
+		Parent:               basetypes.NewStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -138,6 +144,10 @@ func getNewNodeSubInterfaceResourceModelFromData(data *NodeSubInterfaceResourceM
 		newNodeSubInterface.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newNodeSubInterface.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newNodeSubInterface
 }
 
@@ -145,6 +155,27 @@ type NodeSubInterfaceIdentifier struct {
 	Id types.String
 }
 
+func (r *NodeSubInterfaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *NodeSubInterfaceResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Validate the name against the model of the Node only when the name is planned to change
+		if !planData.Name.IsUnknown() && (stateData == nil || stateData.Name.ValueString() != planData.Name.ValueString()) {
+			parentName, _, _ := strings.Cut(planData.Name.ValueString(), ".")
+			checkPortNamesForNodeModel(ctx, &resp.Diagnostics, r.client, planData.NodeId, path.Root("name"), []string{parentName}, false)
+			if !planData.NodeId.IsUnknown() {
+				checkSubInterfaceParentConsistency(ctx, &resp.Diagnostics, r.client, planData.NodeId.ValueString(), planData.Name.ValueString())
+			}
+		}
+	}
+}
+
 func (r *NodeSubInterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_node_sub_interface")
 	resp.TypeName = req.ProviderTypeName + "_node_sub_interface"
@@ -156,6 +187,7 @@ func (r *NodeSubInterfaceResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Node Sub-Interface resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -186,6 +218,9 @@ func (r *NodeSubInterfaceResource) Schema(ctx context.Context, req resource.Sche
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					IsSubInterfaceName(path.Root("vlan_id")),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description is a user defined field to store notes about the Sub-Interface of the Node.",
@@ -206,14 +241,17 @@ func (r *NodeSubInterfaceResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"ipv4_addresses": getSubInterfaceIpv4AddressesSchemaAttribute(),
 			"ipv6_addresses": getSubInterfaceIpv6AddressesSchemaAttribute(),
-			"vlan_id": schema.Float64Attribute{
+			"vlan_id": schema.Int64Attribute{
 				MarkdownDescription: "The VLAN ID to use as encapsulation for the Sub-Interface of the Node. If not provided, the integer in the Sub-Interface `name` will be used as the encapsulation VLAN ID.",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.RequiresReplace(),
-					float64planmodifier.UseStateForUnknown(),
-					SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+					SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
 				},
 			},
 			"vrf_id": schema.StringAttribute{
@@ -235,9 +273,10 @@ func (r *NodeSubInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_node_sub_interface")
@@ -251,6 +290,9 @@ func getSubInterfaceIpv4AddressesSchemaAttribute() schema.SetAttribute {
 		PlanModifiers: []planmodifier.Set{
 			setplanmodifier.UseStateForUnknown(),
 		},
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsIpv4Cidr()),
+		},
 		ElementType: types.StringType,
 	}
 }
@@ -271,6 +313,9 @@ func getSubInterfaceIpv6AddressesSchemaAttribute() schema.SetAttribute {
 		PlanModifiers: []planmodifier.Set{
 			setplanmodifier.UseStateForUnknown(),
 		},
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(IsIpv6Cidr()),
+		},
 		ElementType: types.StringType,
 	}
 }
@@ -283,6 +328,14 @@ func getSubInterfaceIpv6AddressesDataSourceSchemaAttribute() schema.SetAttribute
 	}
 }
 
+func (r *NodeSubInterfaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeFloat64StateToInt64,
+		},
+	}
+}
+
 func (r *NodeSubInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: hyperfabric_node_sub_interface")
 	// Prevent panic if the provider has not been configured.
@@ -393,6 +446,16 @@ func (r *NodeSubInterfaceResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/subInterfaces/%s", data.NodeId.ValueString(), data.SubInterfaceId.ValueString()), "hyperfabric_node_sub_interface", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/subInterfaces/%s", data.NodeId.ValueString(), data.SubInterfaceId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/subInterfaces/%s", data.NodeId.ValueString(), data.SubInterfaceId.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -419,6 +482,11 @@ func (r *NodeSubInterfaceResource) Delete(ctx context.Context, req resource.Dele
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_node_sub_interface with id '%s'", data.Id.ValueString()))
 	checkAndSetNodeSubInterfaceIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/subInterfaces/%s", data.NodeId.ValueString(), data.SubInterfaceId.ValueString()), "hyperfabric_node_sub_interface", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/subInterfaces/%s", data.NodeId.ValueString(), data.SubInterfaceId.ValueString()), "DELETE", nil)
 	if resp.Diagnostics.HasError() {
 		return
@@ -470,7 +538,7 @@ func getAndSetNodeSubInterfaceAttributes(ctx context.Context, diags *diag.Diagno
 			} else if attributeName == "ipv6Addresses" {
 				newNodeSubInterface.Ipv6Addresses = NewSetString(ctx, attributeValue.([]interface{}))
 			} else if attributeName == "vlanId" {
-				newNodeSubInterface.VlanId = basetypes.NewFloat64Value(attributeValue.(float64))
+				newNodeSubInterface.VlanId = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "vrfId" {
 				newNodeSubInterface.VrfId = customTypes.NewUuidFromIdStringValue(attributeValue.(string))
 			} else if attributeName == "parent" {
@@ -478,9 +546,9 @@ func getAndSetNodeSubInterfaceAttributes(ctx context.Context, diags *diag.Diagno
 			} else if attributeName == "metadata" {
 				newNodeSubInterface.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newNodeSubInterface.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newNodeSubInterface.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newNodeSubInterface.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newNodeSubInterface.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
@@ -514,7 +582,7 @@ func getNodeSubInterfaceJsonPayload(ctx context.Context, diags *diag.Diagnostics
 	}
 
 	if !data.VlanId.IsNull() && !data.VlanId.IsUnknown() {
-		payloadMap["vlanId"] = data.VlanId.ValueFloat64()
+		payloadMap["vlanId"] = data.VlanId.ValueInt64()
 	}
 
 	if !data.VrfId.IsNull() && !data.VrfId.IsUnknown() {
@@ -525,7 +593,7 @@ func getNodeSubInterfaceJsonPayload(ctx context.Context, diags *diag.Diagnostics
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
@@ -0,0 +1,249 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodesDataSource{}
+
+func NewNodesDataSource() datasource.DataSource {
+	return &NodesDataSource{}
+}
+
+// NodesDataSource defines the data source implementation.
+type NodesDataSource struct {
+	client *client.Client
+}
+
+// NodesDataSourceModel describes the data source data model.
+type NodesDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	Roles       types.Set    `tfsdk:"roles"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Nodes       types.List   `tfsdk:"nodes"`
+}
+
+// NodeSummaryDataSourceModel describes a Node in the list of Nodes.
+type NodeSummaryDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	NodeId       types.String `tfsdk:"node_id"`
+	FabricId     types.String `tfsdk:"fabric_id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Location     types.String `tfsdk:"location"`
+	ModelName    types.String `tfsdk:"model_name"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	DeviceId     types.String `tfsdk:"device_id"`
+	Roles        types.Set    `tfsdk:"roles"`
+	Labels       types.Set    `tfsdk:"labels"`
+	Annotations  types.Set    `tfsdk:"annotations"`
+}
+
+func NodeSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":            types.StringType,
+			"node_id":       types.StringType,
+			"fabric_id":     types.StringType,
+			"name":          types.StringType,
+			"description":   types.StringType,
+			"enabled":       types.BoolType,
+			"location":      types.StringType,
+			"model_name":    types.StringType,
+			"serial_number": types.StringType,
+			"device_id":     types.StringType,
+			"roles":         types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"labels":        types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"annotations":   types.SetType{ElemType: AnnotationResourceModelAttributeType()},
+		},
+	}
+}
+
+func (d *NodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_nodes")
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_nodes")
+}
+
+func (d *NodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_nodes")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Nodes data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/nodes`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"name_regex":  getNameRegexFilterSchemaAttribute("Nodes"),
+			"labels":      getLabelsFilterSchemaAttribute("Nodes"),
+			"annotations": getAnnotationsFilterSchemaAttribute("Nodes"),
+			"roles":       getRolesFilterSchemaAttribute("Nodes", []string{"LEAF", "SPINE"}),
+			"enabled":     getEnabledFilterSchemaAttribute("Nodes"),
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Nodes matching the filters sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a Node in a Fabric.",
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "`node_id` defines the unique identifier of a Node.",
+							Computed:            true,
+						},
+						"fabric_id": schema.StringAttribute{
+							MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the Node.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the Node.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The enabled admin state of the Node.",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "The location of the Node.",
+							Computed:            true,
+						},
+						"model_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the model of the Node.",
+							Computed:            true,
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "The serial number of the Device bound to the Node.",
+							Computed:            true,
+						},
+						"device_id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the Device bound to the Node.",
+							Computed:            true,
+						},
+						"roles":       getRolesDataSourceSchemaAttribute(),
+						"labels":      getLabelsDataSourceSchemaAttribute(),
+						"annotations": getAnnotationsDataSourceSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_nodes")
+}
+
+func (d *NodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_nodes")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_nodes")
+}
+
+func (d *NodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_nodes")
+	var data *NodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/nodes", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_nodes with id '%s'", data.Id.ValueString()))
+
+	filters := getObjectFilters(ctx, data.NameRegex, data.Labels, data.Annotations, data.Roles, data.Enabled)
+	nodes := getNodesList(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString(), filters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Nodes, _ = types.ListValueFrom(ctx, NodeSummaryDataSourceModelAttributeType(), nodes)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_nodes with id '%s'", data.Id.ValueString()))
+}
+
+// getNodesList returns the Nodes of the Fabric matching the filters sorted by name.
+func getNodesList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId string, filters ObjectFilters) []NodeSummaryDataSourceModel {
+	nodes := make([]NodeSummaryDataSourceModel, 0)
+	for _, node := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes", fabricId), "nodes") {
+		if !filters.matches(node, "name") {
+			continue
+		}
+
+		nodeFabricId := getStringFromMap(node, "fabricId")
+		if nodeFabricId == "" {
+			nodeFabricId = fabricId
+		}
+		annotations, _ := node["annotations"].([]interface{})
+		roles, _ := node["roles"].([]interface{})
+		nodes = append(nodes, NodeSummaryDataSourceModel{
+			Id:           basetypes.NewStringValue(fmt.Sprintf("%s/nodes/%s", nodeFabricId, getStringFromMap(node, "nodeId"))),
+			NodeId:       basetypes.NewStringValue(getStringFromMap(node, "nodeId")),
+			FabricId:     basetypes.NewStringValue(nodeFabricId),
+			Name:         basetypes.NewStringValue(getStringFromMap(node, "name")),
+			Description:  basetypes.NewStringValue(getStringFromMap(node, "description")),
+			Enabled:      basetypes.NewBoolValue(node["enabled"] == true),
+			Location:     basetypes.NewStringValue(getStringFromMap(node, "location")),
+			ModelName:    basetypes.NewStringValue(getStringFromMap(node, "modelName")),
+			SerialNumber: basetypes.NewStringValue(getStringFromMap(node, "serialNumber")),
+			DeviceId:     basetypes.NewStringValue(getStringFromMap(node, "deviceId")),
+			Roles:        NewSetString(ctx, roles),
+			Labels:       getObjectLabelsSet(ctx, node),
+			Annotations:  NewNodeAnnotationsSet(ctx, annotations, basetypes.NewSetNull(AnnotationResourceModelAttributeType()), basetypes.NewBoolNull()),
+		})
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Name.ValueString() < nodes[j].Name.ValueString()
+	})
+	return nodes
+}
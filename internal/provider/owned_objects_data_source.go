@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OwnedObjectsDataSource{}
+
+func NewOwnedObjectsDataSource() datasource.DataSource {
+	return &OwnedObjectsDataSource{}
+}
+
+// OwnedObjectsDataSource defines the data source implementation.
+type OwnedObjectsDataSource struct {
+	client *client.Client
+}
+
+// OwnedObjectsDataSourceModel describes the data source data model.
+type OwnedObjectsDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Label    types.String `tfsdk:"label"`
+	FabricId types.String `tfsdk:"fabric_id"`
+	Objects  types.List   `tfsdk:"objects"`
+}
+
+// OwnedObjectDataSourceModel describes an object owned by a label.
+type OwnedObjectDataSourceModel struct {
+	Type types.String `tfsdk:"type"`
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func OwnedObjectDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type": types.StringType,
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}
+}
+
+func (d *OwnedObjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_owned_objects")
+	resp.TypeName = req.ProviderTypeName + "_owned_objects"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_owned_objects")
+}
+
+func (d *OwnedObjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_owned_objects")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Owned Objects data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`id` is set to the label used to look up the owned objects.",
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label stored in the `managed-by` annotation of the objects. Defaults to the `label` of the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` restricts the lookup to the objects of a single Fabric.",
+				Optional:            true,
+			},
+			"objects": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of objects owned by the label.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The resource type of the object (i.e. `hyperfabric_vni`).",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The id of the object in the format used to import the resource.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the object.",
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_owned_objects")
+}
+
+func (d *OwnedObjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_owned_objects")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_owned_objects")
+}
+
+func (d *OwnedObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_owned_objects")
+	var data *OwnedObjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Label.IsNull() || data.Label.IsUnknown() || data.Label.ValueString() == "" {
+		data.Label = basetypes.NewStringValue(globalLabel)
+	}
+	data.Id = data.Label
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_owned_objects with label '%s'", data.Label.ValueString()))
+
+	objects := getOwnedObjects(ctx, d.client, data.Label.ValueString(), data.FabricId.ValueString())
+	data.Objects, _ = types.ListValueFrom(ctx, OwnedObjectDataSourceModelAttributeType(), objects)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_owned_objects with label '%s'", data.Id.ValueString()))
+}
+
+// getOwnedObjects walks the Fabrics and their child objects and returns the objects with a managed-by annotation set to label.
+func getOwnedObjects(ctx context.Context, client *client.Client, label, fabricId string) []OwnedObjectDataSourceModel {
+	objects := make([]OwnedObjectDataSourceModel, 0)
+	appendIfOwned := func(object map[string]interface{}, resourceType, id string) {
+		if getObjectOwner(object) == label {
+			objects = append(objects, OwnedObjectDataSourceModel{
+				Type: basetypes.NewStringValue(resourceType),
+				Id:   basetypes.NewStringValue(id),
+				Name: basetypes.NewStringValue(getStringFromMap(object, "name")),
+			})
+		}
+	}
+
+	for _, fabric := range getFabricObjectsList(ctx, client, "/api/v1/fabrics", "fabrics") {
+		currentFabricId := getStringFromMap(fabric, "fabricId")
+		if currentFabricId == "" || (fabricId != "" && fabricId != currentFabricId && fabricId != getStringFromMap(fabric, "name")) {
+			continue
+		}
+		appendIfOwned(fabric, "hyperfabric_fabric", currentFabricId)
+
+		for _, node := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/nodes", currentFabricId), "nodes") {
+			nodeId := fmt.Sprintf("%s/nodes/%s", currentFabricId, getStringFromMap(node, "nodeId"))
+			appendIfOwned(node, "hyperfabric_node", nodeId)
+
+			for _, child := range []struct{ resourceType, path string }{
+				{"hyperfabric_node_port", "ports"},
+				{"hyperfabric_node_loopback", "loopbacks"},
+				{"hyperfabric_node_sub_interface", "subInterfaces"},
+				{"hyperfabric_node_breakout", "breakouts"},
+				{"hyperfabric_node_bgp_neighbor", "bgpNeighbors"},
+			} {
+				for _, object := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/%s", nodeId, child.path), child.path) {
+					appendIfOwned(object, child.resourceType, fmt.Sprintf("%s/%s/%s", nodeId, child.path, getStringFromMap(object, "id")))
+				}
+			}
+		}
+
+		for _, vrf := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs", currentFabricId), "vrfs") {
+			vrfId := fmt.Sprintf("%s/vrfs/%s", currentFabricId, getStringFromMap(vrf, "id"))
+			appendIfOwned(vrf, "hyperfabric_vrf", vrfId)
+
+			for _, object := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes", vrfId), "staticRoutes") {
+				appendIfOwned(object, "hyperfabric_vrf_static_route", fmt.Sprintf("%s/staticRoutes/%s", vrfId, getStringFromMap(object, "id")))
+			}
+		}
+
+		for _, child := range []struct{ resourceType, path string }{
+			{"hyperfabric_vni", "vnis"},
+			{"hyperfabric_port_channel", "portChannels"},
+			{"hyperfabric_prefix_list", "prefixLists"},
+			{"hyperfabric_route_policy", "routePolicies"},
+		} {
+			for _, object := range getFabricObjectsList(ctx, client, fmt.Sprintf("/api/v1/fabrics/%s/%s", currentFabricId, child.path), child.path) {
+				appendIfOwned(object, child.resourceType, fmt.Sprintf("%s/%s/%s", currentFabricId, child.path, getStringFromMap(object, "id")))
+			}
+		}
+	}
+	return objects
+}
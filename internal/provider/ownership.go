@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The name of the annotation used to stamp the provider label on the objects it creates.
+// Like the position annotation of a Node, the annotation is never exposed in the annotations attribute.
+const managedByAnnotationName = "managed-by"
+
+const (
+	ownershipCheckError    = "error"
+	ownershipCheckWarning  = "warning"
+	ownershipCheckDisabled = "disabled"
+)
+
+// Set by the provider configuration, the ownership check determines what happens when an object owned by a
+// different label is updated or deleted.
+var globalOwnershipCheck string = ownershipCheckError
+
+func getManagedByAnnotationPayload() map[string]string {
+	return map[string]string{
+		"name":     managedByAnnotationName,
+		"value":    globalLabel,
+		"dataType": "STRING",
+	}
+}
+
+// getObjectOwner returns the label stored in the managed-by annotation of the object attributes.
+func getObjectOwner(attributes map[string]interface{}) string {
+	annotations, _ := attributes["annotations"].([]interface{})
+	for _, annotation := range annotations {
+		if annotationMap, ok := annotation.(map[string]interface{}); ok && annotationMap["name"] == managedByAnnotationName {
+			if owner, ok := annotationMap["value"].(string); ok {
+				return owner
+			}
+		}
+	}
+	return ""
+}
+
+// preserveObjectOwner replaces the label of the managed-by annotation in the update payload with the owner of the
+// object, so an object owned by a different label keeps its owner when updated.
+func preserveObjectOwner(jsonPayload *gabs.Container, attributes map[string]interface{}) {
+	owner := getObjectOwner(attributes)
+	if owner == "" {
+		return
+	}
+
+	annotations, _ := jsonPayload.Path("annotations").Data().([]interface{})
+	for _, annotation := range annotations {
+		if annotationMap, ok := annotation.(map[string]interface{}); ok && annotationMap["name"] == managedByAnnotationName {
+			annotationMap["value"] = owner
+		}
+	}
+}
+
+// checkObjectOwnership retrieves the object at objectPath and reports when it is owned by a different label than
+// the label of the provider. Objects without owner, or that cannot be retrieved, are never reported.
+func checkObjectOwnership(ctx context.Context, diags *diag.Diagnostics, client *client.Client, objectPath, resourceType, action string) {
+	if globalOwnershipCheck == ownershipCheckDisabled || client == nil {
+		return
+	}
+
+	var requestDiags diag.Diagnostics
+	requestData := DoRestRequest(ctx, &requestDiags, client, objectPath, "GET", nil)
+	if requestDiags.HasError() || requestData == nil || requestData.Data() == nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to retrieve the owner of '%s'", objectPath))
+		return
+	}
+
+	attributes, ok := requestData.Data().(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	checkObjectAttributesOwnership(diags, attributes, objectPath, resourceType, action)
+}
+
+// checkObjectAttributesOwnership reports when the object at objectPath, described by its already retrieved
+// attributes, is owned by a different label than the label of the provider.
+func checkObjectAttributesOwnership(diags *diag.Diagnostics, attributes map[string]interface{}, objectPath, resourceType, action string) {
+	if globalOwnershipCheck == ownershipCheckDisabled {
+		return
+	}
+
+	owner := getObjectOwner(attributes)
+	if owner == "" || owner == globalLabel {
+		return
+	}
+
+	summary := fmt.Sprintf("The %s is owned by a different label", resourceType)
+	detail := fmt.Sprintf("The %s '%s' is managed by label '%s' while the provider is configured with label '%s'.", resourceType, objectPath, owner, globalLabel)
+	if globalOwnershipCheck == ownershipCheckWarning {
+		diags.AddWarning(summary, fmt.Sprintf("%s Proceeding with the %s anyway.", detail, action))
+	} else {
+		diags.AddError(summary, fmt.Sprintf("%s Refusing to %s an object managed by another Terraform workspace or operator. Set the provider 'ownership_check' attribute to 'warning' or 'disabled' to override.", detail, action))
+	}
+}
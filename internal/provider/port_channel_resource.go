@@ -0,0 +1,836 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	customTypes "github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/provider/custom_types"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PortChannelResource{}
+var _ resource.ResourceWithImportState = &PortChannelResource{}
+var _ resource.ResourceWithModifyPlan = &PortChannelResource{}
+
+func NewPortChannelResource() resource.Resource {
+	return &PortChannelResource{}
+}
+
+// PortChannelResource defines the resource implementation.
+type PortChannelResource struct {
+	client *client.Client
+}
+
+// PortChannelResourceModel describes the resource data model.
+type PortChannelResourceModel struct {
+	Id                   types.String                      `tfsdk:"id"`
+	PortChannelId        types.String                      `tfsdk:"port_channel_id"`
+	FabricId             types.String                      `tfsdk:"fabric_id"`
+	Name                 types.String                      `tfsdk:"name"`
+	Description          types.String                      `tfsdk:"description"`
+	Enabled              types.Bool                        `tfsdk:"enabled"`
+	Members              types.Set                         `tfsdk:"members"`
+	LacpMode             types.String                      `tfsdk:"lacp_mode"`
+	LacpRate             types.String                      `tfsdk:"lacp_rate"`
+	MinLinks             types.Int64                       `tfsdk:"min_links"`
+	MultiHoming          types.Bool                        `tfsdk:"multi_homing"`
+	Esi                  types.String                      `tfsdk:"esi"`
+	Roles                types.Set                         `tfsdk:"roles"`
+	Ipv4Addresses        types.Set                         `tfsdk:"ipv4_addresses"`
+	Ipv6Addresses        types.Set                         `tfsdk:"ipv6_addresses"`
+	VrfId                customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	Metadata             types.Object                      `tfsdk:"metadata"`
+	Labels               types.Set                         `tfsdk:"labels"`
+	Annotations          types.Set                         `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool                        `tfsdk:"ignore_external_labels"`
+}
+
+// PortChannelMemberResourceModel describes a member Port of a Port Channel.
+type PortChannelMemberResourceModel struct {
+	NodeId   types.String `tfsdk:"node_id"`
+	PortName types.String `tfsdk:"port_name"`
+}
+
+// {
+// 	"nodeId": "603ce8f2-2e10-409b-9ffe-f19378d46423",
+// 	"portName": "Ethernet1_10"
+// }
+
+func PortChannelMemberResourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"node_id":   types.StringType,
+			"port_name": types.StringType,
+		},
+	}
+}
+
+var esiRegex = regexp.MustCompile(`^([0-9a-fA-F]{2}:){9}[0-9a-fA-F]{2}$`)
+
+func getEmptyPortChannelResourceModel() *PortChannelResourceModel {
+	return &PortChannelResourceModel{
+		Id:                   basetypes.NewStringNull(),
+		PortChannelId:        basetypes.NewStringNull(),
+		FabricId:             basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolNull(),
+		Members:              basetypes.NewSetNull(PortChannelMemberResourceModelAttributeType()),
+		LacpMode:             basetypes.NewStringNull(),
+		LacpRate:             basetypes.NewStringNull(),
+		MinLinks:             basetypes.NewInt64Null(),
+		MultiHoming:          basetypes.NewBoolNull(),
+		Esi:                  basetypes.NewStringNull(),
+		Roles:                basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Ipv4Addresses:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Ipv6Addresses:        basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		VrfId:                customTypes.NewUuidFromIdStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
+	}
+}
+
+func getNewPortChannelResourceModelFromData(data *PortChannelResourceModel) *PortChannelResourceModel {
+	newPortChannel := getEmptyPortChannelResourceModel()
+
+	if !data.Id.IsNull() && !data.Id.IsUnknown() {
+		newPortChannel.Id = data.Id
+	}
+
+	if !data.PortChannelId.IsNull() && !data.PortChannelId.IsUnknown() {
+		newPortChannel.PortChannelId = data.PortChannelId
+	}
+
+	if !data.FabricId.IsNull() && !data.FabricId.IsUnknown() {
+		newPortChannel.FabricId = data.FabricId
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		newPortChannel.Name = data.Name
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		newPortChannel.Description = data.Description
+	}
+
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		newPortChannel.Enabled = data.Enabled
+	}
+
+	if !data.Members.IsNull() && !data.Members.IsUnknown() {
+		newPortChannel.Members = data.Members
+	}
+
+	if !data.LacpMode.IsNull() && !data.LacpMode.IsUnknown() {
+		newPortChannel.LacpMode = data.LacpMode
+	}
+
+	if !data.LacpRate.IsNull() && !data.LacpRate.IsUnknown() {
+		newPortChannel.LacpRate = data.LacpRate
+	}
+
+	if !data.MinLinks.IsNull() && !data.MinLinks.IsUnknown() {
+		newPortChannel.MinLinks = data.MinLinks
+	}
+
+	if !data.MultiHoming.IsNull() && !data.MultiHoming.IsUnknown() {
+		newPortChannel.MultiHoming = data.MultiHoming
+	}
+
+	if !data.Esi.IsNull() && !data.Esi.IsUnknown() {
+		newPortChannel.Esi = data.Esi
+	}
+
+	if !data.Roles.IsNull() && !data.Roles.IsUnknown() {
+		newPortChannel.Roles = data.Roles
+	}
+
+	if !data.Ipv4Addresses.IsNull() && !data.Ipv4Addresses.IsUnknown() {
+		newPortChannel.Ipv4Addresses = data.Ipv4Addresses
+	}
+
+	if !data.Ipv6Addresses.IsNull() && !data.Ipv6Addresses.IsUnknown() {
+		newPortChannel.Ipv6Addresses = data.Ipv6Addresses
+	}
+
+	if !data.VrfId.IsNull() && !data.VrfId.IsUnknown() {
+		newPortChannel.VrfId = data.VrfId
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		newPortChannel.Metadata = data.Metadata
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		newPortChannel.Labels = data.Labels
+	}
+
+	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+		newPortChannel.Annotations = data.Annotations
+	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newPortChannel.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
+	return newPortChannel
+}
+
+type PortChannelIdentifier struct {
+	Id types.String
+}
+
+func (r *PortChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *PortChannelResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		if resp.Diagnostics.HasError() || planData.Members.IsUnknown() {
+			return
+		}
+
+		members := []PortChannelMemberResourceModel{}
+		resp.Diagnostics.Append(planData.Members.ElementsAs(ctx, &members, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The multi-homing state is derived from the number of Nodes of the members, so it is known during plan
+		nodeIds := getPortChannelMemberNodeIds(members)
+		if len(nodeIds) > 2 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Invalid Port Channel Members",
+				fmt.Sprintf("The members of a Port Channel can be spread across at most two nodes for multi-homing, got %d nodes.", len(nodeIds)),
+			)
+			return
+		}
+		if !containsUnknownPortChannelMember(members) {
+			var configEsi types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("esi"), &configEsi)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			planData.MultiHoming = basetypes.NewBoolValue(len(nodeIds) == 2)
+			if len(nodeIds) < 2 && !configEsi.IsNull() && !configEsi.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("esi"),
+					"Invalid Port Channel ESI",
+					"The Ethernet Segment Identifier (ESI) can only be configured when the members of the Port Channel are spread across two nodes.",
+				)
+			}
+
+			// The ESI generated by Hyperfabric changes when the multi-homing state changes
+			if configEsi.IsNull() && stateData != nil && !stateData.MultiHoming.Equal(planData.MultiHoming) {
+				planData.Esi = basetypes.NewStringUnknown()
+			}
+		}
+
+		// Query the Fabric for conflicting members only when the members are planned to change
+		if !planData.FabricId.IsUnknown() && (stateData == nil || !stateData.Members.Equal(planData.Members)) {
+			checkPortChannelMembersConsistency(ctx, &resp.Diagnostics, r.client, planData.FabricId.ValueString(), planData.PortChannelId.ValueString(), members)
+		}
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+	}
+}
+
+// getPortChannelMemberNodeIds returns the unique Node ids of the known members of a Port Channel.
+func getPortChannelMemberNodeIds(members []PortChannelMemberResourceModel) []string {
+	nodeIds := make([]string, 0)
+	for _, member := range members {
+		if !member.NodeId.IsUnknown() && !ContainsString(nodeIds, member.NodeId.ValueString()) {
+			nodeIds = append(nodeIds, member.NodeId.ValueString())
+		}
+	}
+	return nodeIds
+}
+
+func containsUnknownPortChannelMember(members []PortChannelMemberResourceModel) bool {
+	for _, member := range members {
+		if member.NodeId.IsUnknown() || member.PortName.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *PortChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_port_channel")
+	resp.TypeName = req.ProviderTypeName + "_port_channel"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_port_channel")
+}
+
+func (r *PortChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_port_channel")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Port Channel resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a Port Channel in a Fabric.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port_channel_id": schema.StringAttribute{
+				MarkdownDescription: "`port_channel_id` defines the unique identifier of a Port Channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Port Channel.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					IsPortChannelName(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the Port Channel.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "The enabled admin state of the Port Channel.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of member Ports of the Port Channel. The members can be spread across two Nodes for multi-homing.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "The unique Id of a node in the Fabric.",
+							Required:            true,
+						},
+						"port_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the port on the node.",
+							Required:            true,
+							Validators: []validator.String{
+								IsPortName(),
+							},
+						},
+					},
+				},
+			},
+			"lacp_mode": schema.StringAttribute{
+				MarkdownDescription: "The LACP mode of the Port Channel. `STATIC` disables LACP negotiation.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"ACTIVE", "PASSIVE", "STATIC"}...),
+				},
+			},
+			"lacp_rate": schema.StringAttribute{
+				MarkdownDescription: "The rate at which LACP control packets are sent by the Port Channel.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"NORMAL", "FAST"}...),
+				},
+			},
+			"min_links": schema.Int64Attribute{
+				MarkdownDescription: "The minimum number of member Ports that must be up for the Port Channel to be up.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"multi_homing": schema.BoolAttribute{
+				MarkdownDescription: "The multi-homing state of the Port Channel, true when the members are spread across two Nodes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"esi": schema.StringAttribute{
+				MarkdownDescription: "The Ethernet Segment Identifier (ESI) used for the multi-homing of the Port Channel. Generated by Hyperfabric when not provided.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(esiRegex, "value must be an Ethernet Segment Identifier of 10 colon separated hexadecimal bytes (i.e. 00:11:22:33:44:55:66:77:88:99)"),
+				},
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "A set of roles used for the Port Channel.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf([]string{"HOST_PORT", "ROUTED_PORT"}...)),
+				},
+				ElementType: types.StringType,
+			},
+			"ipv4_addresses": schema.SetAttribute{
+				MarkdownDescription: "A set of IPv4 addresses to be configured on the Port Channel.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IsIpv4Cidr()),
+					setvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("vrf_id"),
+					}...),
+				},
+				ElementType: types.StringType,
+			},
+			"ipv6_addresses": schema.SetAttribute{
+				MarkdownDescription: "A set of IPv6 addresses to be configured on the Port Channel.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IsIpv6Cidr()),
+					setvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("vrf_id"),
+					}...),
+				},
+				ElementType: types.StringType,
+			},
+			"vrf_id": schema.StringAttribute{
+				CustomType:          customTypes.UuidFromIdStringType{},
+				MarkdownDescription: "The `vrf_id` of a VRF to associate with the Port Channel. Required when the Port Channel roles include `ROUTED_PORT`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+					CompareUuidWithIdForEquality(),
+				},
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_port_channel")
+}
+
+func (r *PortChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_port_channel")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_port_channel")
+}
+
+func (r *PortChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_port_channel")
+
+	var data *PortChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_port_channel in fabric '%s' with name '%s'", data.FabricId.ValueString(), data.Name.ValueString()))
+
+	jsonPayload := getPortChannelJsonPayload(ctx, &resp.Diagnostics, data, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels", data.FabricId.ValueString()), "POST", jsonPayload)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portChannelContainer, err := container.ArrayElement(0, "portChannels")
+	if err != nil {
+		return
+	}
+
+	portChannelId := StripQuotes(portChannelContainer.Search("id").String())
+	if portChannelId != "" {
+		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/portChannels/%s", data.FabricId.ValueString(), portChannelId))
+		data.PortChannelId = basetypes.NewStringValue(portChannelId)
+		getAndSetPortChannelAttributes(ctx, &resp.Diagnostics, r.client, data)
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PortChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_port_channel")
+	var data *PortChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+	checkAndSetPortChannelIds(data)
+	getAndSetPortChannelAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *PortChannelResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PortChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_port_channel")
+	var data *PortChannelResourceModel
+	var stateData *PortChannelResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+
+	jsonPayload := getPortChannelJsonPayload(ctx, &resp.Diagnostics, data, "update")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels/%s", data.FabricId.ValueString(), data.PortChannelId.ValueString()), "hyperfabric_port_channel", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels/%s", data.FabricId.ValueString(), data.PortChannelId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels/%s", data.FabricId.ValueString(), data.PortChannelId.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetPortChannelAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PortChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_port_channel")
+	var data *PortChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+	checkAndSetPortChannelIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels/%s", data.FabricId.ValueString(), data.PortChannelId.ValueString()), "hyperfabric_port_channel", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels/%s", data.FabricId.ValueString(), data.PortChannelId.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_port_channel with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PortChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_port_channel")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *PortChannelResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_port_channel with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_port_channel with id")
+}
+
+func getAndSetPortChannelAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *PortChannelResourceModel) {
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/portChannels/%s", data.FabricId.ValueString(), data.PortChannelId.ValueString()), "GET", nil)
+	if diags.HasError() {
+		return
+	}
+
+	newPortChannel := *getNewPortChannelResourceModelFromData(data)
+
+	if requestData.Data() != nil {
+		attributes := requestData.Data().(map[string]interface{})
+		for attributeName, attributeValue := range attributes {
+			if attributeName == "fabricId" && (data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.FabricId.ValueString() != attributeValue.(string)) {
+				newPortChannel.FabricId = basetypes.NewStringValue(attributeValue.(string))
+				newPortChannel.Id = basetypes.NewStringValue(fmt.Sprintf("%s/portChannels/%s", newPortChannel.FabricId.ValueString(), newPortChannel.PortChannelId.ValueString()))
+			} else if attributeName == "id" && (data.PortChannelId.IsNull() || data.PortChannelId.IsUnknown() || data.PortChannelId.ValueString() == "" || data.PortChannelId.ValueString() != attributeValue.(string)) {
+				newPortChannel.PortChannelId = basetypes.NewStringValue(attributeValue.(string))
+				newPortChannel.Id = basetypes.NewStringValue(fmt.Sprintf("%s/portChannels/%s", newPortChannel.FabricId.ValueString(), newPortChannel.PortChannelId.ValueString()))
+			} else if attributeName == "name" {
+				newPortChannel.Name = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "description" {
+				newPortChannel.Description = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "enabled" {
+				newPortChannel.Enabled = basetypes.NewBoolValue(attributeValue.(bool))
+			} else if attributeName == "members" {
+				newPortChannel.Members = NewPortChannelMembersSet(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "lacpMode" {
+				newPortChannel.LacpMode = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "lacpRate" {
+				newPortChannel.LacpRate = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "minLinks" {
+				newPortChannel.MinLinks = basetypes.NewInt64Value(int64(attributeValue.(float64)))
+			} else if attributeName == "esi" {
+				newPortChannel.Esi = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "roles" {
+				newPortChannel.Roles = NewSetString(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "ipv4Addresses" {
+				newPortChannel.Ipv4Addresses = NewSetString(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "ipv6Addresses" {
+				newPortChannel.Ipv6Addresses = NewSetString(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "vrfId" {
+				newPortChannel.VrfId = customTypes.NewUuidFromIdStringValue(attributeValue.(string))
+			} else if attributeName == "metadata" {
+				newPortChannel.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
+			} else if attributeName == "labels" {
+				newPortChannel.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
+			} else if attributeName == "annotations" {
+				newPortChannel.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
+			}
+		}
+
+		members := []PortChannelMemberResourceModel{}
+		newPortChannel.Members.ElementsAs(ctx, &members, false)
+		newPortChannel.MultiHoming = basetypes.NewBoolValue(len(getPortChannelMemberNodeIds(members)) == 2)
+	} else {
+		newPortChannel.Id = basetypes.NewStringNull()
+	}
+	*data = newPortChannel
+}
+
+func NewPortChannelMembersSet(ctx context.Context, requestData []interface{}) basetypes.SetValue {
+	members := make([]PortChannelMemberResourceModel, 0)
+	for _, member := range requestData {
+		memberMap, ok := member.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		members = append(members, PortChannelMemberResourceModel{
+			NodeId:   basetypes.NewStringValue(getStringFromMap(memberMap, "nodeId")),
+			PortName: basetypes.NewStringValue(getStringFromMap(memberMap, "portName")),
+		})
+	}
+	membersSet, _ := types.SetValueFrom(ctx, PortChannelMemberResourceModelAttributeType(), members)
+	return membersSet
+}
+
+func getPortChannelMembersJsonPayload(ctx context.Context, data basetypes.SetValue) []map[string]interface{} {
+	members := []PortChannelMemberResourceModel{}
+	data.ElementsAs(ctx, &members, false)
+	memberPayloads := make([]map[string]interface{}, 0)
+	for _, member := range members {
+		memberPayloads = append(memberPayloads, map[string]interface{}{
+			"nodeId":   member.NodeId.ValueString(),
+			"portName": member.PortName.ValueString(),
+		})
+	}
+	return memberPayloads
+}
+
+func getPortChannelJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *PortChannelResourceModel, action string) *gabs.Container {
+	payloadMap := map[string]interface{}{}
+	payloadList := []map[string]interface{}{}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		payloadMap["name"] = data.Name.ValueString()
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		payloadMap["description"] = data.Description.ValueString()
+	}
+
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		payloadMap["enabled"] = data.Enabled.ValueBool()
+	}
+
+	if !data.Members.IsNull() && !data.Members.IsUnknown() {
+		payloadMap["members"] = getPortChannelMembersJsonPayload(ctx, data.Members)
+	}
+
+	if !data.LacpMode.IsNull() && !data.LacpMode.IsUnknown() {
+		payloadMap["lacpMode"] = data.LacpMode.ValueString()
+	}
+
+	if !data.LacpRate.IsNull() && !data.LacpRate.IsUnknown() {
+		payloadMap["lacpRate"] = data.LacpRate.ValueString()
+	}
+
+	if !data.MinLinks.IsNull() && !data.MinLinks.IsUnknown() {
+		payloadMap["minLinks"] = data.MinLinks.ValueInt64()
+	}
+
+	if !data.Esi.IsNull() && !data.Esi.IsUnknown() {
+		payloadMap["esi"] = data.Esi.ValueString()
+	}
+
+	if !data.Roles.IsNull() && !data.Roles.IsUnknown() {
+		payloadMap["roles"] = getSetStringJsonPayload(ctx, data.Roles)
+	}
+
+	if !data.Ipv4Addresses.IsNull() && !data.Ipv4Addresses.IsUnknown() {
+		payloadMap["ipv4Addresses"] = getSetStringJsonPayload(ctx, data.Ipv4Addresses)
+	}
+
+	if !data.Ipv6Addresses.IsNull() && !data.Ipv6Addresses.IsUnknown() {
+		payloadMap["ipv6Addresses"] = getSetStringJsonPayload(ctx, data.Ipv6Addresses)
+	}
+
+	if !data.VrfId.IsNull() && !data.VrfId.IsUnknown() {
+		payloadMap["vrfId"] = data.VrfId.ValueString()
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
+	}
+
+	if isAnnotationsPayloadRequired(data.Annotations) {
+		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
+	}
+
+	var payload map[string]interface{}
+	if action == "create" {
+		payloadList = append(payloadList, payloadMap)
+		payload = map[string]interface{}{"portChannels": payloadList}
+	} else {
+		payload = payloadMap
+	}
+
+	marshalPayload, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddError(
+			"Marshalling of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+
+	jsonPayload, err := gabs.ParseJSON(marshalPayload)
+	if err != nil {
+		diags.AddError(
+			"Construction of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+	return jsonPayload
+}
+
+func checkAndSetPortChannelIds(data *PortChannelResourceModel) {
+	if strings.Contains(data.Id.ValueString(), "/portChannels/") {
+		if data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.PortChannelId.IsNull() || data.PortChannelId.IsUnknown() || data.PortChannelId.ValueString() == "" {
+			splitId := strings.Split(data.Id.ValueString(), "/portChannels/")
+			data.FabricId = basetypes.NewStringValue(splitId[0])
+			data.PortChannelId = basetypes.NewStringValue(splitId[1])
+		}
+	}
+}
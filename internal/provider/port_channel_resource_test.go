@@ -0,0 +1,266 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPortChannelResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that an invalid Port Channel name is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - Validate that an invalid Port Channel name is rejected during plan.")
+				},
+				Config:      testPortChannelResourceHclConfig(fabricName, "invalid_name"),
+				ExpectError: regexp.MustCompile(`Invalid Port Channel Name`),
+			},
+			// Validate that an ESI is rejected during plan when the members are on a single node.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - Validate that an ESI is rejected during plan when the members are on a single node.")
+				},
+				Config:      testPortChannelResourceHclConfig(fabricName, "invalid_esi"),
+				ExpectError: regexp.MustCompile(`Invalid Port Channel ESI`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testPortChannelResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "name", "PortChannel10"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "members.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "multi_homing", "false"),
+					resource.TestCheckResourceAttrSet("hyperfabric_port_channel.test", "lacp_mode"),
+					resource.TestCheckResourceAttrSet("hyperfabric_port_channel.test", "lacp_rate"),
+					resource.TestCheckResourceAttrSet("hyperfabric_port_channel.test", "min_links"),
+				),
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:      "hyperfabric_port_channel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - Update with all config and verify provided values.")
+				},
+				Config:             testPortChannelResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "name", "PortChannel10"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "description", "Dual-homed server"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "members.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "lacp_mode", "ACTIVE"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "lacp_rate", "FAST"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "min_links", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "multi_homing", "true"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "esi", "00:11:22:33:44:55:66:77:88:99"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "roles.0", "HOST_PORT"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "labels.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "annotations.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.#", "2"),
+				),
+			},
+			// Run Plan Only with all config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - Run Plan Only with all config and check that plan is empty.")
+				},
+				Config:             testPortChannelResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Update with routed config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Port Channel - Update with routed config and verify provided values.")
+				},
+				Config:             testPortChannelResourceHclConfig(fabricName, "routed"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "roles.0", "ROUTED_PORT"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "ipv4_addresses.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "ipv4_addresses.0", "10.1.1.1/31"),
+					resource.TestCheckResourceAttr("hyperfabric_port_channel.test", "ipv6_addresses.#", "1"),
+					resource.TestCheckResourceAttrSet("hyperfabric_port_channel.test", "vrf_id"),
+				),
+			},
+		},
+	})
+}
+
+func testPortChannelResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_node" "test2" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node2"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_vrf" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vrf1"
+}
+`, fabricName)
+
+	if configType == "invalid_name" {
+		return base + `
+resource "hyperfabric_port_channel" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Bond10"
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_10"
+		}
+	]
+}
+`
+	} else if configType == "invalid_esi" {
+		return base + `
+resource "hyperfabric_port_channel" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "PortChannel10"
+	esi       = "00:11:22:33:44:55:66:77:88:99"
+	members = [
+		{
+			node_id   = "node1"
+			port_name = "Ethernet1_10"
+		}
+	]
+}
+`
+	} else if configType == "full" {
+		return base + `
+resource "hyperfabric_port_channel" "test" {
+	fabric_id   = hyperfabric_fabric.test.id
+	name        = "PortChannel10"
+	description = "Dual-homed server"
+	enabled     = true
+	lacp_mode   = "ACTIVE"
+	lacp_rate   = "FAST"
+	min_links   = 1
+	esi         = "00:11:22:33:44:55:66:77:88:99"
+	roles       = ["HOST_PORT"]
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_10"
+		},
+		{
+			node_id   = hyperfabric_node.test2.node_id
+			port_name = "Ethernet1_10"
+		}
+	]
+	labels = [
+		"sj01-1-101-AAA01",
+		"blue"
+	]
+	annotations = [
+		{
+			name  = "color"
+			value = "blue"
+		},
+		{
+			data_type = "UINT32"
+			name      = "rack"
+			value     = "1"
+		}
+	]
+}
+
+resource "hyperfabric_vni" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vni1"
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = hyperfabric_port_channel.test.name
+			vlan_id   = 103
+		},
+		{
+			node_id   = hyperfabric_node.test2.node_id
+			port_name = hyperfabric_port_channel.test.name
+			vlan_id   = 103
+		}
+	]
+}
+`
+	} else if configType == "routed" {
+		return base + `
+resource "hyperfabric_port_channel" "test" {
+	fabric_id      = hyperfabric_fabric.test.id
+	name           = "PortChannel10"
+	roles          = ["ROUTED_PORT"]
+	vrf_id         = hyperfabric_vrf.test.vrf_id
+	ipv4_addresses = ["10.1.1.1/31"]
+	ipv6_addresses = ["2001:1::1/127"]
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_10"
+		},
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_11"
+		}
+	]
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_port_channel" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "PortChannel10"
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_10"
+		},
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_11"
+		}
+	]
+}
+`
+	}
+}
@@ -0,0 +1,630 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PrefixListResource{}
+var _ resource.ResourceWithImportState = &PrefixListResource{}
+var _ resource.ResourceWithModifyPlan = &PrefixListResource{}
+
+func NewPrefixListResource() resource.Resource {
+	return &PrefixListResource{}
+}
+
+// PrefixListResource defines the resource implementation.
+type PrefixListResource struct {
+	client *client.Client
+}
+
+// PrefixListResourceModel describes the resource data model.
+type PrefixListResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	PrefixListId         types.String `tfsdk:"prefix_list_id"`
+	FabricId             types.String `tfsdk:"fabric_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Entries              types.Set    `tfsdk:"entries"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
+}
+
+// PrefixListEntryResourceModel describes an entry of a Prefix List.
+type PrefixListEntryResourceModel struct {
+	Sequence types.Int64  `tfsdk:"sequence"`
+	Action   types.String `tfsdk:"action"`
+	Prefix   types.String `tfsdk:"prefix"`
+	Ge       types.Int64  `tfsdk:"ge"`
+	Le       types.Int64  `tfsdk:"le"`
+}
+
+// {
+// 	"sequence": 10,
+// 	"action": "PERMIT",
+// 	"prefix": "10.100.0.0/16",
+// 	"ge": 24,
+// 	"le": 32
+// }
+
+func PrefixListEntryResourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"sequence": types.Int64Type,
+			"action":   types.StringType,
+			"prefix":   types.StringType,
+			"ge":       types.Int64Type,
+			"le":       types.Int64Type,
+		},
+	}
+}
+
+func getEmptyPrefixListResourceModel() *PrefixListResourceModel {
+	return &PrefixListResourceModel{
+		Id:                   basetypes.NewStringNull(),
+		PrefixListId:         basetypes.NewStringNull(),
+		FabricId:             basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Entries:              basetypes.NewSetNull(PrefixListEntryResourceModelAttributeType()),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
+	}
+}
+
+func getNewPrefixListResourceModelFromData(data *PrefixListResourceModel) *PrefixListResourceModel {
+	newPrefixList := getEmptyPrefixListResourceModel()
+
+	if !data.Id.IsNull() && !data.Id.IsUnknown() {
+		newPrefixList.Id = data.Id
+	}
+
+	if !data.PrefixListId.IsNull() && !data.PrefixListId.IsUnknown() {
+		newPrefixList.PrefixListId = data.PrefixListId
+	}
+
+	if !data.FabricId.IsNull() && !data.FabricId.IsUnknown() {
+		newPrefixList.FabricId = data.FabricId
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		newPrefixList.Name = data.Name
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		newPrefixList.Description = data.Description
+	}
+
+	if !data.Entries.IsNull() && !data.Entries.IsUnknown() {
+		newPrefixList.Entries = data.Entries
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		newPrefixList.Metadata = data.Metadata
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		newPrefixList.Labels = data.Labels
+	}
+
+	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+		newPrefixList.Annotations = data.Annotations
+	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newPrefixList.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
+	return newPrefixList
+}
+
+type PrefixListIdentifier struct {
+	Id types.String
+}
+
+func (r *PrefixListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData *PrefixListResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+		if resp.Diagnostics.HasError() || planData.Entries.IsUnknown() {
+			return
+		}
+
+		entries := []PrefixListEntryResourceModel{}
+		resp.Diagnostics.Append(planData.Entries.ElementsAs(ctx, &entries, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		sequences := map[int64]bool{}
+		for _, entry := range entries {
+			if !entry.Sequence.IsUnknown() {
+				if sequences[entry.Sequence.ValueInt64()] {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("entries"),
+						"Duplicate Prefix List Sequence",
+						fmt.Sprintf("The sequence %d can only be used by one entry of the Prefix List.", entry.Sequence.ValueInt64()),
+					)
+				}
+				sequences[entry.Sequence.ValueInt64()] = true
+			}
+
+			if entry.Prefix.IsUnknown() || entry.Ge.IsUnknown() || entry.Le.IsUnknown() {
+				continue
+			}
+			if err := validatePrefixListEntryLengths(entry); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("entries"),
+					"Invalid Prefix List Entry",
+					fmt.Sprintf("The entry with sequence %d is invalid: %s.", entry.Sequence.ValueInt64(), err),
+				)
+			}
+		}
+	}
+}
+
+// validatePrefixListEntryLengths returns an error when the ge and le lengths of the entry are not between the length of its
+// prefix and the maximum length of the IP family of the prefix.
+func validatePrefixListEntryLengths(entry PrefixListEntryResourceModel) error {
+	prefix, err := netip.ParsePrefix(entry.Prefix.ValueString())
+	if err != nil {
+		return nil
+	}
+
+	minLength, maxLength := int64(prefix.Bits()), int64(prefix.Addr().BitLen())
+	if !entry.Ge.IsNull() {
+		if entry.Ge.ValueInt64() <= minLength || entry.Ge.ValueInt64() > maxLength {
+			return fmt.Errorf("ge must be greater than %d and at most %d for the prefix %s", minLength, maxLength, entry.Prefix.ValueString())
+		}
+		minLength = entry.Ge.ValueInt64()
+	}
+	if !entry.Le.IsNull() && (entry.Le.ValueInt64() < minLength || entry.Le.ValueInt64() > maxLength) {
+		return fmt.Errorf("le must be between %d and %d for the prefix %s", minLength, maxLength, entry.Prefix.ValueString())
+	}
+	return nil
+}
+
+func (r *PrefixListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_prefix_list")
+	resp.TypeName = req.ProviderTypeName + "_prefix_list"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_prefix_list")
+}
+
+func (r *PrefixListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_prefix_list")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Prefix List resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a Prefix List in a Fabric.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix_list_id": schema.StringAttribute{
+				MarkdownDescription: "`prefix_list_id` defines the unique identifier of a Prefix List.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Prefix List.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the Prefix List.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+			},
+			"entries": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of entries of the Prefix List evaluated in the order of their sequence.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sequence": schema.Int64Attribute{
+							MarkdownDescription: "The sequence number of the entry in the Prefix List.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4294967294),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The action taken for the prefixes matching the entry.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("PERMIT", "DENY"),
+							},
+						},
+						"prefix": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 or IPv6 prefix matched by the entry in CIDR notation.",
+							Required:            true,
+							Validators: []validator.String{
+								IsIpPrefix(),
+							},
+						},
+						"ge": schema.Int64Attribute{
+							MarkdownDescription: "The minimum length of the prefixes matched by the entry.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 128),
+							},
+						},
+						"le": schema.Int64Attribute{
+							MarkdownDescription: "The maximum length of the prefixes matched by the entry.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 128),
+							},
+						},
+					},
+				},
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_prefix_list")
+}
+
+func (r *PrefixListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_prefix_list")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_prefix_list")
+}
+
+func (r *PrefixListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_prefix_list")
+
+	var data *PrefixListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_prefix_list in fabric '%s' with name '%s'", data.FabricId.ValueString(), data.Name.ValueString()))
+
+	jsonPayload := getPrefixListJsonPayload(ctx, &resp.Diagnostics, data, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists", data.FabricId.ValueString()), "POST", jsonPayload)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefixListContainer, err := container.ArrayElement(0, "prefixLists")
+	if err != nil {
+		return
+	}
+
+	prefixListId := StripQuotes(prefixListContainer.Search("id").String())
+	if prefixListId != "" {
+		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/prefixLists/%s", data.FabricId.ValueString(), prefixListId))
+		data.PrefixListId = basetypes.NewStringValue(prefixListId)
+		getAndSetPrefixListAttributes(ctx, &resp.Diagnostics, r.client, data)
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PrefixListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_prefix_list")
+	var data *PrefixListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+	checkAndSetPrefixListIds(data)
+	getAndSetPrefixListAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *PrefixListResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PrefixListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_prefix_list")
+	var data *PrefixListResourceModel
+	var stateData *PrefixListResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+
+	jsonPayload := getPrefixListJsonPayload(ctx, &resp.Diagnostics, data, "update")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists/%s", data.FabricId.ValueString(), data.PrefixListId.ValueString()), "hyperfabric_prefix_list", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists/%s", data.FabricId.ValueString(), data.PrefixListId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists/%s", data.FabricId.ValueString(), data.PrefixListId.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetPrefixListAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PrefixListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_prefix_list")
+	var data *PrefixListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+	checkAndSetPrefixListIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists/%s", data.FabricId.ValueString(), data.PrefixListId.ValueString()), "hyperfabric_prefix_list", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists/%s", data.FabricId.ValueString(), data.PrefixListId.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_prefix_list with id '%s'", data.Id.ValueString()))
+}
+
+func (r *PrefixListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_prefix_list")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *PrefixListResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_prefix_list with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_prefix_list with id")
+}
+
+func getAndSetPrefixListAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *PrefixListResourceModel) {
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/prefixLists/%s", data.FabricId.ValueString(), data.PrefixListId.ValueString()), "GET", nil)
+	if diags.HasError() {
+		return
+	}
+
+	newPrefixList := *getNewPrefixListResourceModelFromData(data)
+
+	if requestData.Data() != nil {
+		attributes := requestData.Data().(map[string]interface{})
+		for attributeName, attributeValue := range attributes {
+			if attributeName == "fabricId" && (data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.FabricId.ValueString() != attributeValue.(string)) {
+				newPrefixList.FabricId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "id" && (data.PrefixListId.IsNull() || data.PrefixListId.IsUnknown() || data.PrefixListId.ValueString() == "" || data.PrefixListId.ValueString() != attributeValue.(string)) {
+				newPrefixList.PrefixListId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "name" {
+				newPrefixList.Name = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "description" {
+				newPrefixList.Description = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "entries" {
+				newPrefixList.Entries = NewPrefixListEntriesSet(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "metadata" {
+				newPrefixList.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
+			} else if attributeName == "labels" {
+				newPrefixList.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
+			} else if attributeName == "annotations" {
+				newPrefixList.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
+			}
+		}
+		newPrefixList.Id = basetypes.NewStringValue(fmt.Sprintf("%s/prefixLists/%s", newPrefixList.FabricId.ValueString(), newPrefixList.PrefixListId.ValueString()))
+	} else {
+		newPrefixList.Id = basetypes.NewStringNull()
+	}
+	*data = newPrefixList
+}
+
+func NewPrefixListEntriesSet(ctx context.Context, requestData []interface{}) basetypes.SetValue {
+	entries := make([]PrefixListEntryResourceModel, 0)
+	for _, entry := range requestData {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		newEntry := PrefixListEntryResourceModel{
+			Sequence: basetypes.NewInt64Value(getInt64FromMap(entryMap, "sequence")),
+			Action:   basetypes.NewStringValue(getStringFromMap(entryMap, "action")),
+			Prefix:   basetypes.NewStringValue(getStringFromMap(entryMap, "prefix")),
+			Ge:       basetypes.NewInt64Null(),
+			Le:       basetypes.NewInt64Null(),
+		}
+		// A length of 0 means that the length is not restricted
+		if ge := getInt64FromMap(entryMap, "ge"); ge > 0 {
+			newEntry.Ge = basetypes.NewInt64Value(ge)
+		}
+		if le, ok := entryMap["le"].(float64); ok {
+			newEntry.Le = basetypes.NewInt64Value(int64(le))
+		}
+		entries = append(entries, newEntry)
+	}
+	entriesSet, _ := types.SetValueFrom(ctx, PrefixListEntryResourceModelAttributeType(), entries)
+	return entriesSet
+}
+
+func getPrefixListEntriesJsonPayload(ctx context.Context, data basetypes.SetValue) []map[string]interface{} {
+	entries := []PrefixListEntryResourceModel{}
+	data.ElementsAs(ctx, &entries, false)
+	entryPayloads := make([]map[string]interface{}, 0)
+	for _, entry := range entries {
+		entryPayload := map[string]interface{}{
+			"sequence": entry.Sequence.ValueInt64(),
+			"action":   entry.Action.ValueString(),
+			"prefix":   entry.Prefix.ValueString(),
+		}
+		if !entry.Ge.IsNull() && !entry.Ge.IsUnknown() {
+			entryPayload["ge"] = entry.Ge.ValueInt64()
+		}
+		if !entry.Le.IsNull() && !entry.Le.IsUnknown() {
+			entryPayload["le"] = entry.Le.ValueInt64()
+		}
+		entryPayloads = append(entryPayloads, entryPayload)
+	}
+	return entryPayloads
+}
+
+func getPrefixListJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *PrefixListResourceModel, action string) *gabs.Container {
+	payloadMap := map[string]interface{}{}
+	payloadList := []map[string]interface{}{}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		payloadMap["name"] = data.Name.ValueString()
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		payloadMap["description"] = data.Description.ValueString()
+	}
+
+	payloadMap["entries"] = getPrefixListEntriesJsonPayload(ctx, data.Entries)
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
+	}
+
+	if isAnnotationsPayloadRequired(data.Annotations) {
+		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
+	}
+
+	var payload map[string]interface{}
+	if action == "create" {
+		payloadList = append(payloadList, payloadMap)
+		payload = map[string]interface{}{"prefixLists": payloadList}
+	} else {
+		payload = payloadMap
+	}
+
+	marshalPayload, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddError(
+			"Marshalling of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+
+	jsonPayload, err := gabs.ParseJSON(marshalPayload)
+	if err != nil {
+		diags.AddError(
+			"Construction of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+	return jsonPayload
+}
+
+func checkAndSetPrefixListIds(data *PrefixListResourceModel) {
+	if strings.Contains(data.Id.ValueString(), "/prefixLists/") {
+		if data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.PrefixListId.IsNull() || data.PrefixListId.IsUnknown() || data.PrefixListId.ValueString() == "" {
+			splitId := strings.Split(data.Id.ValueString(), "/prefixLists/")
+			data.FabricId = basetypes.NewStringValue(splitId[0])
+			data.PrefixListId = basetypes.NewStringValue(splitId[1])
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPrefixListResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that an entry with a ge length shorter than its prefix is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Prefix List - Validate that an entry with a ge length shorter than its prefix is rejected during plan.")
+				},
+				Config:      testPrefixListResourceHclConfig(fabricName, "invalid_entry"),
+				ExpectError: regexp.MustCompile(`Invalid Prefix List Entry`),
+			},
+			// Validate that a sequence used by two entries is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Prefix List - Validate that a sequence used by two entries is rejected during plan.")
+				},
+				Config:      testPrefixListResourceHclConfig(fabricName, "duplicate_sequence"),
+				ExpectError: regexp.MustCompile(`Duplicate Prefix List Sequence`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Prefix List - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testPrefixListResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hyperfabric_prefix_list.test", "fabric_id", "hyperfabric_fabric.test", "id"),
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "name", "shared-services"),
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "entries.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("hyperfabric_prefix_list.test", "entries.*", map[string]string{
+						"sequence": "10",
+						"action":   "PERMIT",
+						"prefix":   "10.100.0.0/16",
+					}),
+				),
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Prefix List - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:      "hyperfabric_prefix_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Prefix List - Update with all config and verify provided values.")
+				},
+				Config:             testPrefixListResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "name", "shared-services"),
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "description", "Prefixes of the shared services"),
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "entries.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("hyperfabric_prefix_list.test", "entries.*", map[string]string{
+						"sequence": "20",
+						"action":   "PERMIT",
+						"prefix":   "10.200.0.0/16",
+						"ge":       "24",
+						"le":       "32",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("hyperfabric_prefix_list.test", "entries.*", map[string]string{
+						"sequence": "30",
+						"action":   "DENY",
+						"prefix":   "2001:db8::/32",
+					}),
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "labels.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_prefix_list.test", "annotations.#", "2"),
+				),
+			},
+			// Run Plan Only with all config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Prefix List - Run Plan Only with all config and check that plan is empty.")
+				},
+				Config:             testPrefixListResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testPrefixListResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+`, fabricName)
+
+	if configType == "invalid_entry" {
+		return base + `
+resource "hyperfabric_prefix_list" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "shared-services"
+	entries = [
+		{
+			sequence = 10
+			action   = "PERMIT"
+			prefix   = "10.100.0.0/16"
+			ge       = 8
+		}
+	]
+}
+`
+	} else if configType == "duplicate_sequence" {
+		return base + `
+resource "hyperfabric_prefix_list" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "shared-services"
+	entries = [
+		{
+			sequence = 10
+			action   = "PERMIT"
+			prefix   = "10.100.0.0/16"
+		},
+		{
+			sequence = 10
+			action   = "DENY"
+			prefix   = "10.200.0.0/16"
+		}
+	]
+}
+`
+	} else if configType == "full" {
+		return base + `
+resource "hyperfabric_prefix_list" "test" {
+	fabric_id   = hyperfabric_fabric.test.id
+	name        = "shared-services"
+	description = "Prefixes of the shared services"
+	entries = [
+		{
+			sequence = 10
+			action   = "PERMIT"
+			prefix   = "10.100.0.0/16"
+		},
+		{
+			sequence = 20
+			action   = "PERMIT"
+			prefix   = "10.200.0.0/16"
+			ge       = 24
+			le       = 32
+		},
+		{
+			sequence = 30
+			action   = "DENY"
+			prefix   = "2001:db8::/32"
+		}
+	]
+	labels = [
+		"sj01-1-101-AAA01",
+		"blue"
+	]
+	annotations = [
+		{
+			name  = "color"
+			value = "blue"
+		},
+		{
+			data_type = "UINT32"
+			name      = "rack"
+			value     = "1"
+		}
+	]
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_prefix_list" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "shared-services"
+	entries = [
+		{
+			sequence = 10
+			action   = "PERMIT"
+			prefix   = "10.100.0.0/16"
+		}
+	]
+}
+`
+	}
+}
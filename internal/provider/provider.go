@@ -15,7 +15,9 @@ import (
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -30,6 +32,7 @@ var globalLabel string
 // Ensure HyperfabricProvider satisfies various provider interfaces.
 var _ provider.Provider = &HyperfabricProvider{}
 var _ provider.ProviderWithFunctions = &HyperfabricProvider{}
+var _ provider.ProviderWithEphemeralResources = &HyperfabricProvider{}
 
 // HyperfabricProvider defines the provider implementation.
 type HyperfabricProvider struct {
@@ -42,14 +45,18 @@ type HyperfabricProvider struct {
 
 // HyperfabricProviderModel describes the provider data model.
 type HyperfabricProviderModel struct {
-	IsInsecure types.Bool   `tfsdk:"insecure"`
-	Label      types.String `tfsdk:"label"`
-	MaxRetries types.Int32  `tfsdk:"retries"`
-	ProxyUrl   types.String `tfsdk:"proxy_url"`
-	ProxyCreds types.String `tfsdk:"proxy_creds"`
-	Token      types.String `tfsdk:"token"`
-	URL        types.String `tfsdk:"url"`
-	AutoCommit types.Bool   `tfsdk:"auto_commit"`
+	IsInsecure           types.Bool   `tfsdk:"insecure"`
+	Label                types.String `tfsdk:"label"`
+	MaxRetries           types.Int32  `tfsdk:"retries"`
+	ProxyUrl             types.String `tfsdk:"proxy_url"`
+	ProxyCreds           types.String `tfsdk:"proxy_creds"`
+	Token                types.String `tfsdk:"token"`
+	URL                  types.String `tfsdk:"url"`
+	AutoCommit           types.Bool   `tfsdk:"auto_commit"`
+	ConsistencyChecks    types.Bool   `tfsdk:"consistency_checks"`
+	OwnershipCheck       types.String `tfsdk:"ownership_check"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
+	IgnoreLabelPrefixes  types.List   `tfsdk:"ignore_label_prefixes"`
 }
 
 func (p *HyperfabricProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -65,9 +72,16 @@ func (p *HyperfabricProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:            true,
 			},
 			"label": schema.StringAttribute{
-				MarkdownDescription: "Global label for the provider. This can also be set as the HYPERFABRIC_LABEL environment variable. Defaults to `terraform`.",
+				MarkdownDescription: "Global label for the provider. Objects created by the provider are stamped with a `managed-by` annotation set to this label to track their ownership. Objects already owned by a different label keep their owner when updated. This can also be set as the HYPERFABRIC_LABEL environment variable. Defaults to `terraform`.",
 				Optional:            true,
 			},
+			"ownership_check": schema.StringAttribute{
+				MarkdownDescription: "The action taken when updating or deleting an object owned by a different `label`. This can also be set as the HYPERFABRIC_OWNERSHIP_CHECK environment variable. Defaults to `error`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{ownershipCheckError, ownershipCheckWarning, ownershipCheckDisabled}...),
+				},
+			},
 			"proxy_url": schema.StringAttribute{
 				MarkdownDescription: "Proxy Server URL with port number. This can also be set as the HYPERFABRIC_PROXY_URL environment variable.",
 				Optional:            true,
@@ -96,6 +110,19 @@ func (p *HyperfabricProvider) Schema(ctx context.Context, req provider.SchemaReq
 				MarkdownDescription: "Automatically commit changes to the running configuration. This can also be set as the HYPERFABRIC_AUTO_COMMIT environment variable. Defaults to `false`.",
 				Optional:            true,
 			},
+			"consistency_checks": schema.BoolAttribute{
+				MarkdownDescription: "Query the Fabric during plan to detect conflicts with existing objects, such as duplicate VLAN IDs on a port or duplicate Loopback addresses. This can also be set as the HYPERFABRIC_CONSISTENCY_CHECKS environment variable. Defaults to `true`.",
+				Optional:            true,
+			},
+			"ignore_external_labels": schema.BoolAttribute{
+				MarkdownDescription: "Only manage the labels and annotations declared in the configuration. Labels and annotations added to the objects by other tools or in the GUI are ignored and preserved on update. This can also be set as the HYPERFABRIC_IGNORE_EXTERNAL_LABELS environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ignore_label_prefixes": schema.ListAttribute{
+				MarkdownDescription: "A list of prefixes of labels and annotation names that are ignored and preserved on update. This can also be set as the HYPERFABRIC_IGNORE_LABEL_PREFIXES environment variable as a comma-separated list.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -123,6 +150,20 @@ func getBoolAttribute(attribute basetypes.BoolValue, envKey string, defaultValue
 	return attribute.ValueBool()
 }
 
+func getStringListAttribute(ctx context.Context, attribute basetypes.ListValue, envKey string) []string {
+	values := make([]string, 0)
+	if attribute.IsNull() {
+		for _, envValue := range strings.Split(os.Getenv(envKey), ",") {
+			if strings.TrimSpace(envValue) != "" {
+				values = append(values, strings.TrimSpace(envValue))
+			}
+		}
+		return values
+	}
+	attribute.ElementsAs(ctx, &values, false)
+	return values
+}
+
 func getIntAttribute(attribute basetypes.Int32Value, envKey string, defaultValue int) int {
 	if attribute.IsNull() {
 		envValue, err := strconv.ParseInt(os.Getenv(envKey), 10, 32)
@@ -157,6 +198,17 @@ func (p *HyperfabricProvider) Configure(ctx context.Context, req provider.Config
 	proxyUrl := getStringAttribute(data.ProxyUrl, "HYPERFABRIC_PROXY_URL", "")
 	globalLabel = getStringAttribute(data.Label, "HYPERFABRIC_LABEL", "terraform")
 	autoCommit := getBoolAttribute(data.AutoCommit, "HYPERFABRIC_AUTO_COMMIT", false)
+	globalConsistencyChecks = getBoolAttribute(data.ConsistencyChecks, "HYPERFABRIC_CONSISTENCY_CHECKS", true)
+	globalOwnershipCheck = getStringAttribute(data.OwnershipCheck, "HYPERFABRIC_OWNERSHIP_CHECK", ownershipCheckError)
+	if !ContainsString([]string{ownershipCheckError, ownershipCheckWarning, ownershipCheckDisabled}, globalOwnershipCheck) {
+		resp.Diagnostics.AddError(
+			"Incorrect ownership check",
+			fmt.Sprintf("Ownership check '%s' must be one of '%s', '%s' or '%s'", globalOwnershipCheck, ownershipCheckError, ownershipCheckWarning, ownershipCheckDisabled),
+		)
+	}
+
+	globalIgnoreExternalLabels = getBoolAttribute(data.IgnoreExternalLabels, "HYPERFABRIC_IGNORE_EXTERNAL_LABELS", false)
+	globalIgnoreLabelPrefixes = getStringListAttribute(ctx, data.IgnoreLabelPrefixes, "HYPERFABRIC_IGNORE_LABEL_PREFIXES")
 
 	// Client configuration for data sources and resources
 	hyperfabricClient := client.GetClient(url, token, client.Insecure(insecure), client.ProxyUrl(proxyUrl), client.ProxyCreds(proxyCreds), client.MaxRetries(maxRetries), client.AutoCommit(autoCommit))
@@ -172,37 +224,68 @@ func (p *HyperfabricProvider) Resources(ctx context.Context) []func() resource.R
 		NewNodeResource,
 		NewNodeManagementPortResource,
 		NewNodePortResource,
+		NewNodePortsResource,
 		NewNodeLoopbackResource,
 		NewNodeSubInterfaceResource,
+		NewNodeBgpNeighborResource,
 		NewNodeBreakoutResource,
+		NewPortChannelResource,
 		NewConnectionResource,
+		NewConnectionsCsvResource,
 		NewBindToNodeResource,
 		NewUserResource,
 		NewVrfResource,
+		NewVrfStaticRouteResource,
+		NewPrefixListResource,
+		NewRoutePolicyResource,
 		NewVniResource,
+		NewVniMemberResource,
 	}
 }
 
 func (p *HyperfabricProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAlarmsDataSource,
 		NewBearerTokenDataSource,
+		NewBearerTokensDataSource,
+		NewConnectionsDataSource,
+		NewConnectionsCsvDataSource,
 		NewDeviceDataSource,
+		NewDevicesDataSource,
 		NewFabricDataSource,
+		NewFabricsDataSource,
+		NewFabricCablingCheckDataSource,
+		NewFabricTopologyDataSource,
 		NewNodeDataSource,
+		NewNodesDataSource,
 		NewNodeManagementPortDataSource,
 		NewNodePortDataSource,
+		NewNodePortsDataSource,
+		NewNodePortStatusDataSource,
 		NewNodeSubInterfaceDataSource,
 		NewNodeBreakoutDataSource,
 		NewNodeLoopbackDataSource,
+		NewNodeBgpNeighborStatusDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewVrfDataSource,
+		NewVrfsDataSource,
+		NewVrfStaticRouteDataSource,
 		NewVniDataSource,
+		NewVnisDataSource,
+		NewOwnedObjectsDataSource,
+	}
+}
+
+func (p *HyperfabricProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewBearerTokenEphemeralResource,
 	}
 }
 
 func (p *HyperfabricProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// NewExampleFunction,
+		NewSpineLeafCablingPlanFunction,
 	}
 }
 
@@ -0,0 +1,625 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoutePolicyResource{}
+var _ resource.ResourceWithImportState = &RoutePolicyResource{}
+var _ resource.ResourceWithModifyPlan = &RoutePolicyResource{}
+
+func NewRoutePolicyResource() resource.Resource {
+	return &RoutePolicyResource{}
+}
+
+// RoutePolicyResource defines the resource implementation.
+type RoutePolicyResource struct {
+	client *client.Client
+}
+
+// RoutePolicyResourceModel describes the resource data model.
+type RoutePolicyResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	RoutePolicyId        types.String `tfsdk:"route_policy_id"`
+	FabricId             types.String `tfsdk:"fabric_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Statements           types.Set    `tfsdk:"statements"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
+}
+
+// RoutePolicyStatementResourceModel describes a statement of a Route Policy.
+type RoutePolicyStatementResourceModel struct {
+	Sequence           types.Int64  `tfsdk:"sequence"`
+	Action             types.String `tfsdk:"action"`
+	MatchPrefixListId  types.String `tfsdk:"match_prefix_list_id"`
+	SetLocalPreference types.Int64  `tfsdk:"set_local_preference"`
+	SetMed             types.Int64  `tfsdk:"set_med"`
+	SetCommunities     types.Set    `tfsdk:"set_communities"`
+}
+
+// {
+// 	"sequence": 10,
+// 	"action": "PERMIT",
+// 	"matchPrefixListId": "8a1d5c47-2d0e-4d2b-9a8f-0e7c3b2a1f60",
+// 	"setLocalPreference": 200,
+// 	"setMed": 50,
+// 	"setCommunities": ["65000:100"]
+// }
+
+func RoutePolicyStatementResourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"sequence":             types.Int64Type,
+			"action":               types.StringType,
+			"match_prefix_list_id": types.StringType,
+			"set_local_preference": types.Int64Type,
+			"set_med":              types.Int64Type,
+			"set_communities":      types.SetType{ElemType: types.StringType},
+		},
+	}
+}
+
+func getEmptyRoutePolicyResourceModel() *RoutePolicyResourceModel {
+	return &RoutePolicyResourceModel{
+		Id:                   basetypes.NewStringNull(),
+		RoutePolicyId:        basetypes.NewStringNull(),
+		FabricId:             basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Statements:           basetypes.NewSetNull(RoutePolicyStatementResourceModelAttributeType()),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
+	}
+}
+
+func getNewRoutePolicyResourceModelFromData(data *RoutePolicyResourceModel) *RoutePolicyResourceModel {
+	newRoutePolicy := getEmptyRoutePolicyResourceModel()
+
+	if !data.Id.IsNull() && !data.Id.IsUnknown() {
+		newRoutePolicy.Id = data.Id
+	}
+
+	if !data.RoutePolicyId.IsNull() && !data.RoutePolicyId.IsUnknown() {
+		newRoutePolicy.RoutePolicyId = data.RoutePolicyId
+	}
+
+	if !data.FabricId.IsNull() && !data.FabricId.IsUnknown() {
+		newRoutePolicy.FabricId = data.FabricId
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		newRoutePolicy.Name = data.Name
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		newRoutePolicy.Description = data.Description
+	}
+
+	if !data.Statements.IsNull() && !data.Statements.IsUnknown() {
+		newRoutePolicy.Statements = data.Statements
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		newRoutePolicy.Metadata = data.Metadata
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		newRoutePolicy.Labels = data.Labels
+	}
+
+	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+		newRoutePolicy.Annotations = data.Annotations
+	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newRoutePolicy.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
+	return newRoutePolicy
+}
+
+type RoutePolicyIdentifier struct {
+	Id types.String
+}
+
+func (r *RoutePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData *RoutePolicyResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+		if resp.Diagnostics.HasError() || planData.Statements.IsUnknown() {
+			return
+		}
+
+		statements := []RoutePolicyStatementResourceModel{}
+		resp.Diagnostics.Append(planData.Statements.ElementsAs(ctx, &statements, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		sequences := map[int64]bool{}
+		for _, statement := range statements {
+			if !statement.Sequence.IsUnknown() {
+				if sequences[statement.Sequence.ValueInt64()] {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("statements"),
+						"Duplicate Route Policy Sequence",
+						fmt.Sprintf("The sequence %d can only be used by one statement of the Route Policy.", statement.Sequence.ValueInt64()),
+					)
+				}
+				sequences[statement.Sequence.ValueInt64()] = true
+			}
+
+			if statement.Action.ValueString() == "DENY" && (!statement.SetLocalPreference.IsNull() || !statement.SetMed.IsNull() || !statement.SetCommunities.IsNull()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("statements"),
+					"Invalid Route Policy Statement",
+					fmt.Sprintf("The statement with sequence %d denies the matching routes and cannot set attributes on them.", statement.Sequence.ValueInt64()),
+				)
+			}
+		}
+	}
+}
+
+func (r *RoutePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_route_policy")
+	resp.TypeName = req.ProviderTypeName + "_route_policy"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_route_policy")
+}
+
+func (r *RoutePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_route_policy")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Route Policy resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a Route Policy in a Fabric.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"route_policy_id": schema.StringAttribute{
+				MarkdownDescription: "`route_policy_id` defines the unique identifier of a Route Policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Route Policy.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the Route Policy.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+			},
+			"statements": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of statements of the Route Policy evaluated in the order of their sequence.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sequence": schema.Int64Attribute{
+							MarkdownDescription: "The sequence number of the statement in the Route Policy.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The action taken for the routes matching the statement.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("PERMIT", "DENY"),
+							},
+						},
+						"match_prefix_list_id": schema.StringAttribute{
+							MarkdownDescription: "The `prefix_list_id` of a Prefix List matching the routes of the statement. All routes are matched when not set.",
+							Optional:            true,
+						},
+						"set_local_preference": schema.Int64Attribute{
+							MarkdownDescription: "The BGP local preference set on the routes matching the statement.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 4294967295),
+							},
+						},
+						"set_med": schema.Int64Attribute{
+							MarkdownDescription: "The BGP Multi-Exit Discriminator (MED) set on the routes matching the statement.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 4294967295),
+							},
+						},
+						"set_communities": schema.SetAttribute{
+							MarkdownDescription: "A set of BGP communities set on the routes matching the statement.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(IsBgpCommunity()),
+							},
+						},
+					},
+				},
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_route_policy")
+}
+
+func (r *RoutePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_route_policy")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_route_policy")
+}
+
+func (r *RoutePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_route_policy")
+
+	var data *RoutePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_route_policy in fabric '%s' with name '%s'", data.FabricId.ValueString(), data.Name.ValueString()))
+
+	jsonPayload := getRoutePolicyJsonPayload(ctx, &resp.Diagnostics, data, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies", data.FabricId.ValueString()), "POST", jsonPayload)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routePolicyContainer, err := container.ArrayElement(0, "routePolicies")
+	if err != nil {
+		return
+	}
+
+	routePolicyId := StripQuotes(routePolicyContainer.Search("id").String())
+	if routePolicyId != "" {
+		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/routePolicies/%s", data.FabricId.ValueString(), routePolicyId))
+		data.RoutePolicyId = basetypes.NewStringValue(routePolicyId)
+		getAndSetRoutePolicyAttributes(ctx, &resp.Diagnostics, r.client, data)
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+}
+
+func (r *RoutePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_route_policy")
+	var data *RoutePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+	checkAndSetRoutePolicyIds(data)
+	getAndSetRoutePolicyAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *RoutePolicyResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+}
+
+func (r *RoutePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_route_policy")
+	var data *RoutePolicyResourceModel
+	var stateData *RoutePolicyResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+
+	jsonPayload := getRoutePolicyJsonPayload(ctx, &resp.Diagnostics, data, "update")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies/%s", data.FabricId.ValueString(), data.RoutePolicyId.ValueString()), "hyperfabric_route_policy", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies/%s", data.FabricId.ValueString(), data.RoutePolicyId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies/%s", data.FabricId.ValueString(), data.RoutePolicyId.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetRoutePolicyAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+}
+
+func (r *RoutePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_route_policy")
+	var data *RoutePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+	checkAndSetRoutePolicyIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies/%s", data.FabricId.ValueString(), data.RoutePolicyId.ValueString()), "hyperfabric_route_policy", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies/%s", data.FabricId.ValueString(), data.RoutePolicyId.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_route_policy with id '%s'", data.Id.ValueString()))
+}
+
+func (r *RoutePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_route_policy")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *RoutePolicyResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_route_policy with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_route_policy with id")
+}
+
+func getAndSetRoutePolicyAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *RoutePolicyResourceModel) {
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/routePolicies/%s", data.FabricId.ValueString(), data.RoutePolicyId.ValueString()), "GET", nil)
+	if diags.HasError() {
+		return
+	}
+
+	newRoutePolicy := *getNewRoutePolicyResourceModelFromData(data)
+
+	if requestData.Data() != nil {
+		attributes := requestData.Data().(map[string]interface{})
+		for attributeName, attributeValue := range attributes {
+			if attributeName == "fabricId" && (data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.FabricId.ValueString() != attributeValue.(string)) {
+				newRoutePolicy.FabricId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "id" && (data.RoutePolicyId.IsNull() || data.RoutePolicyId.IsUnknown() || data.RoutePolicyId.ValueString() == "" || data.RoutePolicyId.ValueString() != attributeValue.(string)) {
+				newRoutePolicy.RoutePolicyId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "name" {
+				newRoutePolicy.Name = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "description" {
+				newRoutePolicy.Description = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "statements" {
+				newRoutePolicy.Statements = NewRoutePolicyStatementsSet(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "metadata" {
+				newRoutePolicy.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
+			} else if attributeName == "labels" {
+				newRoutePolicy.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
+			} else if attributeName == "annotations" {
+				newRoutePolicy.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
+			}
+		}
+		newRoutePolicy.Id = basetypes.NewStringValue(fmt.Sprintf("%s/routePolicies/%s", newRoutePolicy.FabricId.ValueString(), newRoutePolicy.RoutePolicyId.ValueString()))
+	} else {
+		newRoutePolicy.Id = basetypes.NewStringNull()
+	}
+	*data = newRoutePolicy
+}
+
+func NewRoutePolicyStatementsSet(ctx context.Context, requestData []interface{}) basetypes.SetValue {
+	statements := make([]RoutePolicyStatementResourceModel, 0)
+	for _, statement := range requestData {
+		statementMap, ok := statement.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		newStatement := RoutePolicyStatementResourceModel{
+			Sequence:           basetypes.NewInt64Value(getInt64FromMap(statementMap, "sequence")),
+			Action:             basetypes.NewStringValue(getStringFromMap(statementMap, "action")),
+			MatchPrefixListId:  basetypes.NewStringNull(),
+			SetLocalPreference: basetypes.NewInt64Null(),
+			SetMed:             basetypes.NewInt64Null(),
+			SetCommunities:     basetypes.NewSetNull(types.StringType),
+		}
+		if matchPrefixListId := getStringFromMap(statementMap, "matchPrefixListId"); matchPrefixListId != "" {
+			newStatement.MatchPrefixListId = basetypes.NewStringValue(matchPrefixListId)
+		}
+		if setLocalPreference, ok := statementMap["setLocalPreference"].(float64); ok {
+			newStatement.SetLocalPreference = basetypes.NewInt64Value(int64(setLocalPreference))
+		}
+		if setMed, ok := statementMap["setMed"].(float64); ok {
+			newStatement.SetMed = basetypes.NewInt64Value(int64(setMed))
+		}
+		if setCommunities := getStringsFromMap(statementMap, "setCommunities"); len(setCommunities) > 0 {
+			newStatement.SetCommunities, _ = types.SetValueFrom(ctx, types.StringType, setCommunities)
+		}
+		statements = append(statements, newStatement)
+	}
+	statementsSet, _ := types.SetValueFrom(ctx, RoutePolicyStatementResourceModelAttributeType(), statements)
+	return statementsSet
+}
+
+func getRoutePolicyStatementsJsonPayload(ctx context.Context, data basetypes.SetValue) []map[string]interface{} {
+	statements := []RoutePolicyStatementResourceModel{}
+	data.ElementsAs(ctx, &statements, false)
+	statementPayloads := make([]map[string]interface{}, 0)
+	for _, statement := range statements {
+		statementPayload := map[string]interface{}{
+			"sequence": statement.Sequence.ValueInt64(),
+			"action":   statement.Action.ValueString(),
+		}
+		if !statement.MatchPrefixListId.IsNull() && !statement.MatchPrefixListId.IsUnknown() {
+			statementPayload["matchPrefixListId"] = statement.MatchPrefixListId.ValueString()
+		}
+		if !statement.SetLocalPreference.IsNull() && !statement.SetLocalPreference.IsUnknown() {
+			statementPayload["setLocalPreference"] = statement.SetLocalPreference.ValueInt64()
+		}
+		if !statement.SetMed.IsNull() && !statement.SetMed.IsUnknown() {
+			statementPayload["setMed"] = statement.SetMed.ValueInt64()
+		}
+		if !statement.SetCommunities.IsNull() && !statement.SetCommunities.IsUnknown() {
+			statementPayload["setCommunities"] = getSetStringJsonPayload(ctx, statement.SetCommunities)
+		}
+		statementPayloads = append(statementPayloads, statementPayload)
+	}
+	return statementPayloads
+}
+
+func getRoutePolicyJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *RoutePolicyResourceModel, action string) *gabs.Container {
+	payloadMap := map[string]interface{}{}
+	payloadList := []map[string]interface{}{}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		payloadMap["name"] = data.Name.ValueString()
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		payloadMap["description"] = data.Description.ValueString()
+	}
+
+	payloadMap["statements"] = getRoutePolicyStatementsJsonPayload(ctx, data.Statements)
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
+	}
+
+	if isAnnotationsPayloadRequired(data.Annotations) {
+		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
+	}
+
+	var payload map[string]interface{}
+	if action == "create" {
+		payloadList = append(payloadList, payloadMap)
+		payload = map[string]interface{}{"routePolicies": payloadList}
+	} else {
+		payload = payloadMap
+	}
+
+	marshalPayload, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddError(
+			"Marshalling of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+
+	jsonPayload, err := gabs.ParseJSON(marshalPayload)
+	if err != nil {
+		diags.AddError(
+			"Construction of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+	return jsonPayload
+}
+
+func checkAndSetRoutePolicyIds(data *RoutePolicyResourceModel) {
+	if strings.Contains(data.Id.ValueString(), "/routePolicies/") {
+		if data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.RoutePolicyId.IsNull() || data.RoutePolicyId.IsUnknown() || data.RoutePolicyId.ValueString() == "" {
+			splitId := strings.Split(data.Id.ValueString(), "/routePolicies/")
+			data.FabricId = basetypes.NewStringValue(splitId[0])
+			data.RoutePolicyId = basetypes.NewStringValue(splitId[1])
+		}
+	}
+}
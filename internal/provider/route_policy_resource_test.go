@@ -0,0 +1,211 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutePolicyResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that an invalid BGP community is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Route Policy - Validate that an invalid BGP community is rejected during plan.")
+				},
+				Config:      testRoutePolicyResourceHclConfig(fabricName, "invalid_community"),
+				ExpectError: regexp.MustCompile(`Invalid BGP Community`),
+			},
+			// Validate that a statement denying routes and setting attributes is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Route Policy - Validate that a statement denying routes and setting attributes is rejected during plan.")
+				},
+				Config:      testRoutePolicyResourceHclConfig(fabricName, "invalid_statement"),
+				ExpectError: regexp.MustCompile(`Invalid Route Policy Statement`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Route Policy - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testRoutePolicyResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hyperfabric_route_policy.test", "fabric_id", "hyperfabric_fabric.test", "id"),
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "name", "leak-shared-services"),
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "statements.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("hyperfabric_route_policy.test", "statements.*", map[string]string{
+						"sequence": "10",
+						"action":   "PERMIT",
+					}),
+				),
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Route Policy - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:      "hyperfabric_route_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Route Policy - Update with all config and verify provided values.")
+				},
+				Config:             testRoutePolicyResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "name", "leak-shared-services"),
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "description", "Leak the shared services into the tenant VRFs"),
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "statements.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("hyperfabric_route_policy.test", "statements.*", map[string]string{
+						"sequence":             "10",
+						"action":               "PERMIT",
+						"set_local_preference": "200",
+						"set_med":              "50",
+						"set_communities.#":    "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("hyperfabric_route_policy.test", "statements.*", map[string]string{
+						"sequence": "20",
+						"action":   "DENY",
+					}),
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "labels.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_route_policy.test", "annotations.#", "2"),
+					resource.TestCheckResourceAttrPair("hyperfabric_vrf.test", "import_route_policy_id", "hyperfabric_route_policy.test", "route_policy_id"),
+				),
+			},
+			// Run Plan Only with all config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: Route Policy - Run Plan Only with all config and check that plan is empty.")
+				},
+				Config:             testRoutePolicyResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testRoutePolicyResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_prefix_list" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "shared-services"
+	entries = [
+		{
+			sequence = 10
+			action   = "PERMIT"
+			prefix   = "10.100.0.0/16"
+		}
+	]
+}
+`, fabricName)
+
+	if configType == "invalid_community" {
+		return base + `
+resource "hyperfabric_route_policy" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "leak-shared-services"
+	statements = [
+		{
+			sequence        = 10
+			action          = "PERMIT"
+			set_communities = ["65536:100"]
+		}
+	]
+}
+`
+	} else if configType == "invalid_statement" {
+		return base + `
+resource "hyperfabric_route_policy" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "leak-shared-services"
+	statements = [
+		{
+			sequence             = 10
+			action               = "DENY"
+			set_local_preference = 200
+		}
+	]
+}
+`
+	} else if configType == "full" {
+		return base + `
+resource "hyperfabric_route_policy" "test" {
+	fabric_id   = hyperfabric_fabric.test.id
+	name        = "leak-shared-services"
+	description = "Leak the shared services into the tenant VRFs"
+	statements = [
+		{
+			sequence             = 10
+			action               = "PERMIT"
+			match_prefix_list_id = hyperfabric_prefix_list.test.prefix_list_id
+			set_local_preference = 200
+			set_med              = 50
+			set_communities      = ["65000:100", "65000:200"]
+		},
+		{
+			sequence = 20
+			action   = "DENY"
+		}
+	]
+	labels = [
+		"sj01-1-101-AAA01",
+		"blue"
+	]
+	annotations = [
+		{
+			name  = "color"
+			value = "blue"
+		},
+		{
+			data_type = "UINT32"
+			name      = "rack"
+			value     = "1"
+		}
+	]
+}
+
+resource "hyperfabric_vrf" "test" {
+	fabric_id              = hyperfabric_fabric.test.id
+	name                   = "Vrf1"
+	import_route_targets   = ["65000:100"]
+	import_route_policy_id = hyperfabric_route_policy.test.route_policy_id
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_route_policy" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "leak-shared-services"
+	statements = [
+		{
+			sequence = 10
+			action   = "PERMIT"
+		}
+	]
+}
+`
+	}
+}
@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SpineLeafCablingPlanFunction{}
+
+func NewSpineLeafCablingPlanFunction() function.Function {
+	return &SpineLeafCablingPlanFunction{}
+}
+
+// SpineLeafCablingPlanFunction defines the function implementation.
+type SpineLeafCablingPlanFunction struct{}
+
+// SpineLeafCablingPlanNode describes a Node given to the spine_leaf_cabling_plan function.
+type SpineLeafCablingPlanNode struct {
+	Name  string   `tfsdk:"name"`
+	Roles []string `tfsdk:"roles"`
+	Ports []string `tfsdk:"ports"`
+}
+
+// SpineLeafCablingPlanConnection describes a Connection returned by the spine_leaf_cabling_plan function.
+type SpineLeafCablingPlanConnection struct {
+	Local  SpineLeafCablingPlanEndpoint `tfsdk:"local"`
+	Remote SpineLeafCablingPlanEndpoint `tfsdk:"remote"`
+}
+
+// SpineLeafCablingPlanEndpoint describes the port of a Node used as local or remote side of a planned Connection.
+type SpineLeafCablingPlanEndpoint struct {
+	NodeName string `tfsdk:"node_name"`
+	PortName string `tfsdk:"port_name"`
+}
+
+func SpineLeafCablingPlanNodeAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"roles": types.ListType{ElemType: types.StringType},
+			"ports": types.ListType{ElemType: types.StringType},
+		},
+	}
+}
+
+func SpineLeafCablingPlanEndpointAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"node_name": types.StringType,
+			"port_name": types.StringType,
+		},
+	}
+}
+
+func SpineLeafCablingPlanConnectionAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"local":  SpineLeafCablingPlanEndpointAttributeType(),
+			"remote": SpineLeafCablingPlanEndpointAttributeType(),
+		},
+	}
+}
+
+func (f *SpineLeafCablingPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of function: spine_leaf_cabling_plan")
+	resp.Name = "spine_leaf_cabling_plan"
+	tflog.Debug(ctx, "End metadata of function: spine_leaf_cabling_plan")
+}
+
+func (f *SpineLeafCablingPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	tflog.Debug(ctx, "Start definition of function: spine_leaf_cabling_plan")
+	resp.Definition = function.Definition{
+		Summary:             "Generate the spine to leaf Connections of a SPINE_LEAF Fabric",
+		MarkdownDescription: "Returns a map of the Connections required to connect every `LEAF` Node to every `SPINE` Node, keyed by the name of the `LEAF` Node and its uplink port (i.e. `leaf1:Ethernet1_31`). The `local` side of every Connection is the `LEAF` Node and the `remote` side is the `SPINE` Node.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "nodes",
+				MarkdownDescription: "The list of Nodes of the Fabric. Each Node is an object with a `name`, the `roles` of the Node (`LEAF` or `SPINE`) and the ordered list of `ports` available for spine to leaf Connections (the uplinks of a `LEAF` Node or the downlinks of a `SPINE` Node). Ports are allocated in the order of the list. Nodes with neither role are ignored.",
+				ElementType:         SpineLeafCablingPlanNodeAttributeType(),
+			},
+			function.Int64Parameter{
+				Name:                "links_per_spine",
+				MarkdownDescription: "The number of Connections between each `LEAF` Node and each `SPINE` Node. The number of uplinks of each `LEAF` Node is the number of `SPINE` Nodes multiplied by `links_per_spine`.",
+			},
+			function.StringParameter{
+				Name:                "allocation",
+				MarkdownDescription: "The port allocation strategy. Possible values are `GROUPED` and `STRIPED`. `GROUPED` allocates consecutive ports of a `LEAF` Node to the same `SPINE` Node and consecutive ports of a `SPINE` Node to the same `LEAF` Node. `STRIPED` allocates consecutive ports of a `LEAF` Node to successive `SPINE` Nodes and consecutive ports of a `SPINE` Node to successive `LEAF` Nodes.",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf("GROUPED", "STRIPED"),
+				},
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: SpineLeafCablingPlanConnectionAttributeType(),
+		},
+	}
+	tflog.Debug(ctx, "End definition of function: spine_leaf_cabling_plan")
+}
+
+func (f *SpineLeafCablingPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	tflog.Debug(ctx, "Start run of function: spine_leaf_cabling_plan")
+	var nodes []SpineLeafCablingPlanNode
+	var linksPerSpine int64
+	var allocation string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &nodes, &linksPerSpine, &allocation))
+	if resp.Error != nil {
+		return
+	}
+
+	connections, funcErr := getSpineLeafCablingPlan(nodes, linksPerSpine, allocation)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, connections))
+	tflog.Debug(ctx, "End run of function: spine_leaf_cabling_plan")
+}
+
+func getSpineLeafCablingPlan(nodes []SpineLeafCablingPlanNode, linksPerSpine int64, allocation string) (map[string]SpineLeafCablingPlanConnection, *function.FuncError) {
+	if linksPerSpine < 1 {
+		return nil, function.NewArgumentFuncError(1, fmt.Sprintf("links_per_spine must be at least 1, got: %d.", linksPerSpine))
+	}
+
+	spines := []SpineLeafCablingPlanNode{}
+	leaves := []SpineLeafCablingPlanNode{}
+	names := map[string]bool{}
+	for _, node := range nodes {
+		if node.Name == "" {
+			return nil, function.NewArgumentFuncError(0, "Every Node must have a name.")
+		}
+		if names[node.Name] {
+			return nil, function.NewArgumentFuncError(0, fmt.Sprintf("Node %q is defined more than once.", node.Name))
+		}
+		names[node.Name] = true
+
+		isSpine, isLeaf := ContainsString(node.Roles, "SPINE"), ContainsString(node.Roles, "LEAF")
+		if isSpine && isLeaf {
+			return nil, function.NewArgumentFuncError(0, fmt.Sprintf("Node %q cannot have both the LEAF and SPINE roles.", node.Name))
+		} else if !isSpine && !isLeaf {
+			continue
+		}
+		if err := checkSpineLeafCablingPlanPorts(node); err != "" {
+			return nil, function.NewArgumentFuncError(0, err)
+		}
+		if isSpine {
+			spines = append(spines, node)
+		} else {
+			leaves = append(leaves, node)
+		}
+	}
+
+	if len(spines) == 0 || len(leaves) == 0 {
+		return nil, function.NewArgumentFuncError(0, "At least one LEAF Node and one SPINE Node are required.")
+	}
+
+	uplinks := len(spines) * int(linksPerSpine)
+	for _, leaf := range leaves {
+		if len(leaf.Ports) < uplinks {
+			return nil, function.NewArgumentFuncError(0, fmt.Sprintf("LEAF Node %q requires %d ports, got: %d.", leaf.Name, uplinks, len(leaf.Ports)))
+		}
+	}
+	downlinks := len(leaves) * int(linksPerSpine)
+	for _, spine := range spines {
+		if len(spine.Ports) < downlinks {
+			return nil, function.NewArgumentFuncError(0, fmt.Sprintf("SPINE Node %q requires %d ports, got: %d.", spine.Name, downlinks, len(spine.Ports)))
+		}
+	}
+
+	connections := map[string]SpineLeafCablingPlanConnection{}
+	links := int(linksPerSpine)
+	for leafIndex, leaf := range leaves {
+		for spineIndex, spine := range spines {
+			for link := 0; link < links; link++ {
+				var leafPort, spinePort string
+				if allocation == "STRIPED" {
+					leafPort = leaf.Ports[link*len(spines)+spineIndex]
+					spinePort = spine.Ports[link*len(leaves)+leafIndex]
+				} else {
+					leafPort = leaf.Ports[spineIndex*links+link]
+					spinePort = spine.Ports[leafIndex*links+link]
+				}
+				connections[fmt.Sprintf("%s:%s", leaf.Name, leafPort)] = SpineLeafCablingPlanConnection{
+					Local:  SpineLeafCablingPlanEndpoint{NodeName: leaf.Name, PortName: leafPort},
+					Remote: SpineLeafCablingPlanEndpoint{NodeName: spine.Name, PortName: spinePort},
+				}
+			}
+		}
+	}
+	return connections, nil
+}
+
+// checkSpineLeafCablingPlanPorts returns an error message when the ports of a Node are invalid, duplicated
+// or when a port is listed together with one of its breakout ports.
+func checkSpineLeafCablingPlanPorts(node SpineLeafCablingPlanNode) string {
+	ports := map[string]bool{}
+	for _, port := range node.Ports {
+		if !isValidPortName(port) {
+			return fmt.Sprintf("Node %q has an invalid port name (i.e. Ethernet1_10 or Ethernet1_1_1), got: %q.", node.Name, port)
+		}
+		if ports[port] {
+			return fmt.Sprintf("Node %q has port %q defined more than once.", node.Name, port)
+		}
+		ports[port] = true
+	}
+	for _, port := range node.Ports {
+		if matches := portNameRegex.FindStringSubmatch(port); matches[3] != "" {
+			parent := fmt.Sprintf("Ethernet%s_%s", matches[1], matches[2])
+			if ports[parent] {
+				return fmt.Sprintf("Node %q cannot use port %q together with its breakout port %q.", node.Name, parent, port)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSpineLeafCablingPlanFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		// Provider defined functions are only available in Terraform 1.8 and later.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Run with GROUPED allocation and verify the planned Connections.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: SpineLeafCablingPlan Function - Run with GROUPED allocation and verify the planned Connections.")
+				},
+				Config: testSpineLeafCablingPlanFunctionHclConfig(`["Ethernet1_31", "Ethernet1_32", "Ethernet1_29_1", "Ethernet1_29_2"]`, "GROUPED"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"leaf1:Ethernet1_31":   testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_31", "spine1", "Ethernet1_1"),
+						"leaf1:Ethernet1_32":   testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_32", "spine1", "Ethernet1_2"),
+						"leaf1:Ethernet1_29_1": testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_29_1", "spine2", "Ethernet1_1"),
+						"leaf1:Ethernet1_29_2": testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_29_2", "spine2", "Ethernet1_2"),
+						"leaf2:Ethernet1_31":   testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_31", "spine1", "Ethernet1_3"),
+						"leaf2:Ethernet1_32":   testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_32", "spine1", "Ethernet1_4"),
+						"leaf2:Ethernet1_29_1": testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_29_1", "spine2", "Ethernet1_3"),
+						"leaf2:Ethernet1_29_2": testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_29_2", "spine2", "Ethernet1_4"),
+					})),
+				},
+			},
+			// Run with STRIPED allocation and verify the planned Connections.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: SpineLeafCablingPlan Function - Run with STRIPED allocation and verify the planned Connections.")
+				},
+				Config: testSpineLeafCablingPlanFunctionHclConfig(`["Ethernet1_31", "Ethernet1_32", "Ethernet1_29_1", "Ethernet1_29_2"]`, "STRIPED"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"leaf1:Ethernet1_31":   testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_31", "spine1", "Ethernet1_1"),
+						"leaf1:Ethernet1_32":   testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_32", "spine2", "Ethernet1_1"),
+						"leaf1:Ethernet1_29_1": testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_29_1", "spine1", "Ethernet1_3"),
+						"leaf1:Ethernet1_29_2": testSpineLeafCablingPlanConnectionCheck("leaf1", "Ethernet1_29_2", "spine2", "Ethernet1_3"),
+						"leaf2:Ethernet1_31":   testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_31", "spine1", "Ethernet1_2"),
+						"leaf2:Ethernet1_32":   testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_32", "spine2", "Ethernet1_2"),
+						"leaf2:Ethernet1_29_1": testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_29_1", "spine1", "Ethernet1_4"),
+						"leaf2:Ethernet1_29_2": testSpineLeafCablingPlanConnectionCheck("leaf2", "Ethernet1_29_2", "spine2", "Ethernet1_4"),
+					})),
+				},
+			},
+			// Run with a port and one of its breakout ports and verify the error.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: SpineLeafCablingPlan Function - Run with a port and one of its breakout ports and verify the error.")
+				},
+				Config:      testSpineLeafCablingPlanFunctionHclConfig(`["Ethernet1_31", "Ethernet1_32", "Ethernet1_29", "Ethernet1_29_2"]`, "GROUPED"),
+				ExpectError: regexp.MustCompile(`cannot use port "Ethernet1_29" together with its breakout port`),
+			},
+			// Run with not enough ports and verify the error.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: SpineLeafCablingPlan Function - Run with not enough ports and verify the error.")
+				},
+				Config:      testSpineLeafCablingPlanFunctionHclConfig(`["Ethernet1_31", "Ethernet1_32"]`, "GROUPED"),
+				ExpectError: regexp.MustCompile(`LEAF Node "leaf1" requires 4 ports, got: 2`),
+			},
+		},
+	})
+}
+
+func testSpineLeafCablingPlanConnectionCheck(localNode, localPort, remoteNode, remotePort string) knownvalue.Check {
+	return knownvalue.ObjectExact(map[string]knownvalue.Check{
+		"local": knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"node_name": knownvalue.StringExact(localNode),
+			"port_name": knownvalue.StringExact(localPort),
+		}),
+		"remote": knownvalue.ObjectExact(map[string]knownvalue.Check{
+			"node_name": knownvalue.StringExact(remoteNode),
+			"port_name": knownvalue.StringExact(remotePort),
+		}),
+	})
+}
+
+func testSpineLeafCablingPlanFunctionHclConfig(leafPorts string, allocation string) string {
+	return fmt.Sprintf(`
+locals {
+	spine_ports = ["Ethernet1_1", "Ethernet1_2", "Ethernet1_3", "Ethernet1_4"]
+	leaf_ports  = %[1]s
+}
+
+output "test" {
+	value = provider::hyperfabric::spine_leaf_cabling_plan([
+		{ name = "spine1", roles = ["SPINE"], ports = local.spine_ports },
+		{ name = "spine2", roles = ["SPINE"], ports = local.spine_ports },
+		{ name = "leaf1", roles = ["LEAF"], ports = local.leaf_ports },
+		{ name = "leaf2", roles = ["LEAF"], ports = local.leaf_ports },
+	], 2, "%[2]s")
+}
+`, leafPorts, allocation)
+}
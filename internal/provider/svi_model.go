@@ -9,12 +9,14 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -74,6 +76,9 @@ func getSviSchemaAttribute() schema.SingleNestedAttribute {
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IsIpv4Cidr()),
+				},
 				ElementType: types.StringType,
 			},
 			"ipv6_addresses": schema.SetAttribute{
@@ -83,6 +88,9 @@ func getSviSchemaAttribute() schema.SingleNestedAttribute {
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IsIpv6Cidr()),
+				},
 				ElementType: types.StringType,
 			},
 			// "vlan_id": schema.Int32Attribute{
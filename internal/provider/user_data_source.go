@@ -65,8 +65,9 @@ func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "The role assigned to the User.",
 				Computed:            true,
 			},
-			"metadata": getMetadataSchemaAttribute(),
-			"labels":   getLabelsDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
 			// "annotations": getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
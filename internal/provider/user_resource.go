@@ -44,27 +44,29 @@ type UserResource struct {
 
 // UserResourceModel describes the resource data model.
 type UserResourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Email     types.String `tfsdk:"email"`
-	LastLogin types.String `tfsdk:"last_login"`
-	Enabled   types.Bool   `tfsdk:"enabled"`
-	Provider  types.String `tfsdk:"auth_provider"`
-	Role      types.String `tfsdk:"role"`
-	Metadata  types.Object `tfsdk:"metadata"`
-	Labels    types.Set    `tfsdk:"labels"`
+	Id                   types.String `tfsdk:"id"`
+	Email                types.String `tfsdk:"email"`
+	LastLogin            types.String `tfsdk:"last_login"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Provider             types.String `tfsdk:"auth_provider"`
+	Role                 types.String `tfsdk:"role"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
 	// Annotations types.Set    `tfsdk:"annotations"`
 }
 
 func getEmptyUserResourceModel() *UserResourceModel {
 	return &UserResourceModel{
-		Id:        basetypes.NewStringNull(),
-		Email:     basetypes.NewStringNull(),
-		LastLogin: basetypes.NewStringNull(),
-		Enabled:   basetypes.NewBoolValue(false),
-		Provider:  basetypes.NewStringNull(),
-		Role:      basetypes.NewStringNull(),
-		Metadata:  basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:    basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Id:                   basetypes.NewStringNull(),
+		Email:                basetypes.NewStringNull(),
+		LastLogin:            basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolValue(false),
+		Provider:             basetypes.NewStringNull(),
+		Role:                 basetypes.NewStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 		// Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
 	}
 }
@@ -103,6 +105,10 @@ func getNewUserResourceModelFromData(data *UserResourceModel) *UserResourceModel
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		newUser.Labels = data.Labels
 	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newUser.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
 	return newUser
 }
 
@@ -172,8 +178,9 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringvalidator.OneOf([]string{"ADMIN", "READ_WRITE", "READ_ONLY"}...),
 				},
 			},
-			"metadata": getMetadataSchemaAttribute(),
-			"labels":   getLabelsSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 			// "annotations": getAnnotationsSchemaAttribute(),
 		},
 	}
@@ -290,6 +297,11 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/users/%s", data.Id.ValueString()), jsonPayload, stateData.Labels, basetypes.NewSetNull(AnnotationResourceModelAttributeType()), data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/users/%s", data.Id.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -360,9 +372,9 @@ func getAndSetUserAttributes(ctx context.Context, diags *diag.Diagnostics, clien
 			} else if attributeName == "metadata" {
 				newUser.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newUser.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newUser.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 				// } else if attributeName == "annotations" {
-				// 	newUser.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				// 	newUser.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
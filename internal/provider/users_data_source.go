@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *client.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	EmailRegex types.String `tfsdk:"email_regex"`
+	Labels     types.Set    `tfsdk:"labels"`
+	Roles      types.Set    `tfsdk:"roles"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Users      types.List   `tfsdk:"users"`
+}
+
+// UserSummaryDataSourceModel describes a User in the list of Users.
+type UserSummaryDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Email        types.String `tfsdk:"email"`
+	LastLogin    types.String `tfsdk:"last_login"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	AuthProvider types.String `tfsdk:"auth_provider"`
+	Role         types.String `tfsdk:"role"`
+	Labels       types.Set    `tfsdk:"labels"`
+}
+
+func UserSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":            types.StringType,
+			"email":         types.StringType,
+			"last_login":    types.StringType,
+			"enabled":       types.BoolType,
+			"auth_provider": types.StringType,
+			"role":          types.StringType,
+			"labels":        types.SetType{ElemType: SetStringResourceModelAttributeType()},
+		},
+	}
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_users")
+	resp.TypeName = req.ProviderTypeName + "_users"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_users")
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_users")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Users data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to `users`.",
+				Computed:            true,
+			},
+			"email_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression to only return the Users with a matching email.",
+				Optional:            true,
+				Validators: []validator.String{
+					IsRegex(),
+				},
+			},
+			"labels": getLabelsFilterSchemaAttribute("Users"),
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "A set of roles to only return the Users with one of these roles.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf([]string{"ADMIN", "READ_WRITE", "READ_ONLY"}...)),
+				},
+			},
+			"enabled": getEnabledFilterSchemaAttribute("Users"),
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of Users matching the filters sorted by email.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a User.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the User.",
+							Computed:            true,
+						},
+						"last_login": schema.StringAttribute{
+							MarkdownDescription: "The last time the User logged into the application.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The enabled state of the User.",
+							Computed:            true,
+						},
+						"auth_provider": schema.StringAttribute{
+							MarkdownDescription: "The authentication provider for the User.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role assigned to the User.",
+							Computed:            true,
+						},
+						"labels": getLabelsDataSourceSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_users")
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_users")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_users")
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_users")
+	var data *UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue("users")
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_users with email regex '%s'", data.EmailRegex.ValueString()))
+
+	// A User has a single role returned under the role key instead of a list of roles
+	filters := getObjectFilters(ctx, data.EmailRegex, data.Labels, basetypes.NewMapNull(types.StringType), basetypes.NewSetNull(types.StringType), data.Enabled)
+	roles := getSetStringJsonPayload(ctx, data.Roles)
+	users := getUsersList(ctx, &resp.Diagnostics, d.client, filters, roles)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Users, _ = types.ListValueFrom(ctx, UserSummaryDataSourceModelAttributeType(), users)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_users with id '%s'", data.Id.ValueString()))
+}
+
+// getUsersList returns the Users matching the filters and one of the roles sorted by email.
+func getUsersList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, filters ObjectFilters, roles []string) []UserSummaryDataSourceModel {
+	users := make([]UserSummaryDataSourceModel, 0)
+	for _, user := range getObjectsList(ctx, diags, client, "/api/v1/users", "users") {
+		if !filters.matches(user, "email") || (len(roles) > 0 && !ContainsString(roles, getStringFromMap(user, "role"))) {
+			continue
+		}
+		users = append(users, UserSummaryDataSourceModel{
+			Id:           basetypes.NewStringValue(getStringFromMap(user, "id")),
+			Email:        basetypes.NewStringValue(getStringFromMap(user, "email")),
+			LastLogin:    basetypes.NewStringValue(getStringFromMap(user, "lastLogin")),
+			Enabled:      basetypes.NewBoolValue(user["enabled"] == true),
+			AuthProvider: basetypes.NewStringValue(getStringFromMap(user, "provider")),
+			Role:         basetypes.NewStringValue(getStringFromMap(user, "role")),
+			Labels:       getObjectLabelsSet(ctx, user),
+		})
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].Email.ValueString() < users[j].Email.ValueString()
+	})
+	return users
+}
@@ -9,6 +9,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
@@ -16,10 +17,13 @@ import (
 	"github.com/Jeffail/gabs/v2"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func ContainsString(strings []string, matchString string) bool {
@@ -146,25 +150,25 @@ func (m setToBoolNullWhenStateIsNullPlanIsUnknownDuringUpdate) PlanModifyBool(ct
 	}
 }
 
-type setToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate struct{}
+type setToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate struct{}
 
-func SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate() planmodifier.Float64 {
-	return setToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate{}
+func SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate() planmodifier.Int64 {
+	return setToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate{}
 }
 
-func (m setToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate) Description(_ context.Context) string {
-	return "During the update phase, set the value of this attribute to Float64Null when the state value is null and the plan value is unknown."
+func (m setToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate) Description(_ context.Context) string {
+	return "During the update phase, set the value of this attribute to Int64Null when the state value is null and the plan value is unknown."
 }
 
-func (m setToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate) MarkdownDescription(_ context.Context) string {
-	return "During the update phase, set the value of this attribute to Float64Null when the state value is null and the plan value is unknown."
+func (m setToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate) MarkdownDescription(_ context.Context) string {
+	return "During the update phase, set the value of this attribute to Int64Null when the state value is null and the plan value is unknown."
 }
 
 // Custom plan modifier to set the plan value to null under certain conditions
-func (m setToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
-	// Set the plan value to Float64Type null when state value is null and plan value is unknown during an Update
+func (m setToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Set the plan value to Int64Type null when state value is null and plan value is unknown during an Update
 	if !req.State.Raw.IsNull() && req.StateValue.IsNull() && req.PlanValue.IsUnknown() {
-		resp.PlanValue = types.Float64Null()
+		resp.PlanValue = types.Int64Null()
 	}
 }
 
@@ -281,3 +285,51 @@ func IsEmptySingleNestedAttribute(attributes map[string]attr.Value) bool {
 	}
 	return true
 }
+
+// nodeReferenceMatches returns true when the object, such as a side of a Connection or an Alarm, references the Node
+// with the given ID or name in its nodeId or nodeName attributes.
+func nodeReferenceMatches(object map[string]interface{}, nodeId string) bool {
+	return getStringFromMap(object, "nodeId") == nodeId || getStringFromMap(object, "nodeName") == nodeId
+}
+
+// upgradeFloat64StateToInt64 upgrades the state of resources from schema version 0, where whole numbers such as VLAN IDs,
+// VNIs, MTUs and ASNs were stored as Float64 attributes, to schema version 1 where they are Int64 attributes.
+// Float64 and Int64 attributes are both stored as a Terraform number, so the prior state is decoded with the current schema
+// and every number is truncated to its integer value.
+func upgradeFloat64StateToInt64(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	unmarshalOpts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	}
+
+	priorState, err := req.RawState.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), unmarshalOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read prior state for the upgrade to schema version 1",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	upgradedState, err := tftypes.Transform(priorState, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.Type().Is(tftypes.Number) || !value.IsKnown() || value.IsNull() {
+			return value, nil
+		}
+		var number big.Float
+		if err := value.As(&number); err != nil {
+			return value, err
+		}
+		integer, _ := number.Int(nil)
+		return tftypes.NewValue(tftypes.Number, new(big.Float).SetInt(integer)), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to convert prior state for the upgrade to schema version 1",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	resp.State.Raw = upgradedState
+}
@@ -0,0 +1,836 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	ipFamilyAny  = ""
+	ipFamilyIpv4 = "IPv4"
+	ipFamilyIpv6 = "IPv6"
+)
+
+var (
+	portNameRegex         = regexp.MustCompile(`^Ethernet(\d+)_(\d+)(?:_(\d+))?$`)
+	portChannelNameRegex  = regexp.MustCompile(`^PortChannel(\d+)$`)
+	subInterfaceNameRegex = regexp.MustCompile(`^(Ethernet\d+_\d+(?:_\d+)?)\.(\d+)$`)
+	loopbackNameRegex     = regexp.MustCompile(`^Loopback(\d+)$`)
+	hostnameRegex         = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*\.?$`)
+)
+
+// ipMatchesFamily returns true when the address belongs to the requested IP family.
+func ipMatchesFamily(address netip.Addr, family string) bool {
+	switch family {
+	case ipFamilyIpv4:
+		return address.Is4()
+	case ipFamilyIpv6:
+		return address.Is6() && !address.Is4In6()
+	}
+	return true
+}
+
+func ipFamilyName(family string) string {
+	if family == ipFamilyAny {
+		return "IP"
+	}
+	return family
+}
+
+var _ validator.String = IpAddressValidator{}
+
+// IpAddressValidator validates that a string is an IP host address or, when
+// Cidr is set, an interface address in CIDR notation (i.e. 10.1.0.1/24) of the
+// requested IP family. Empty strings are ignored as they are used to unset an
+// address.
+type IpAddressValidator struct {
+	Family string
+	Cidr   bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v IpAddressValidator) Description(_ context.Context) string {
+	if v.Cidr {
+		return fmt.Sprintf("value must be an %s address in CIDR notation", ipFamilyName(v.Family))
+	}
+	return fmt.Sprintf("value must be an %s address without prefix length", ipFamilyName(v.Family))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v IpAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v IpAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	var address netip.Addr
+	if v.Cidr {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid IP Address",
+				fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value),
+			)
+			return
+		}
+		address = prefix.Addr()
+	} else {
+		parsedAddress, err := netip.ParseAddr(value)
+		if err != nil || parsedAddress.Zone() != "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid IP Address",
+				fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value),
+			)
+			return
+		}
+		address = parsedAddress
+	}
+
+	if !ipMatchesFamily(address, v.Family) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address Family",
+			fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsIpv4Address returns a validator which ensures that the string is an IPv4 host address.
+func IsIpv4Address() validator.String {
+	return IpAddressValidator{Family: ipFamilyIpv4}
+}
+
+// IsIpv6Address returns a validator which ensures that the string is an IPv6 host address.
+func IsIpv6Address() validator.String {
+	return IpAddressValidator{Family: ipFamilyIpv6}
+}
+
+// IsIpAddress returns a validator which ensures that the string is an IPv4 or IPv6 host address.
+func IsIpAddress() validator.String {
+	return IpAddressValidator{Family: ipFamilyAny}
+}
+
+// IsIpv4Cidr returns a validator which ensures that the string is an IPv4 address in CIDR notation.
+func IsIpv4Cidr() validator.String {
+	return IpAddressValidator{Family: ipFamilyIpv4, Cidr: true}
+}
+
+// IsIpv6Cidr returns a validator which ensures that the string is an IPv6 address in CIDR notation.
+func IsIpv6Cidr() validator.String {
+	return IpAddressValidator{Family: ipFamilyIpv6, Cidr: true}
+}
+
+var _ validator.String = IpPrefixValidator{}
+
+// IpPrefixValidator validates that a string is a network prefix in CIDR
+// notation (i.e. 10.1.0.0/16) without host bits set.
+type IpPrefixValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v IpPrefixValidator) Description(_ context.Context) string {
+	return "value must be an IP network prefix in CIDR notation without host bits set"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v IpPrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v IpPrefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil || prefix.Masked() != prefix {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Prefix",
+			fmt.Sprintf("Attribute %s %s (i.e. 10.1.0.0/16 or 2001:1::/64), got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsIpPrefix returns a validator which ensures that the string is an IPv4 or IPv6 network prefix.
+func IsIpPrefix() validator.String {
+	return IpPrefixValidator{}
+}
+
+var _ validator.String = RouteTargetValidator{}
+
+// RouteTargetValidator validates that a string is a BGP extended community route
+// target in the `<ASN>:<Number>` or `<IPv4 Address>:<Number>` format.
+type RouteTargetValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v RouteTargetValidator) Description(_ context.Context) string {
+	return "value must be a route target in the <ASN>:<Number> or <IPv4 Address>:<Number> format"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v RouteTargetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v RouteTargetValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !isValidRouteTarget(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Route Target",
+			fmt.Sprintf("Attribute %s %s (i.e. 65001:100 or 10.1.0.1:100), got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// isValidRouteTarget returns true when the value is a route target with a 2-byte ASN and a 4-byte number, or with a
+// 4-byte ASN or an IPv4 address and a 2-byte number.
+func isValidRouteTarget(value string) bool {
+	index := strings.LastIndex(value, ":")
+	if index <= 0 {
+		return false
+	}
+
+	number, err := strconv.ParseUint(value[index+1:], 10, 32)
+	if err != nil {
+		return false
+	}
+
+	administrator := value[:index]
+	if address, err := netip.ParseAddr(administrator); err == nil {
+		return address.Is4() && number <= 65535
+	}
+
+	asn, err := strconv.ParseUint(administrator, 10, 32)
+	if err != nil || asn == 0 {
+		return false
+	}
+	return asn <= 65535 || number <= 65535
+}
+
+// IsRouteTarget returns a validator which ensures that the string is a valid route target.
+func IsRouteTarget() validator.String {
+	return RouteTargetValidator{}
+}
+
+var _ validator.String = BgpCommunityValidator{}
+
+// BgpCommunityValidator validates that a string is a BGP standard community in
+// the `<Number>:<Number>` format with numbers between 0 and 65535.
+type BgpCommunityValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v BgpCommunityValidator) Description(_ context.Context) string {
+	return "value must be a BGP community in the <Number>:<Number> format with numbers between 0 and 65535"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v BgpCommunityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v BgpCommunityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	parts := strings.Split(value, ":")
+	if len(parts) == 2 {
+		_, highErr := strconv.ParseUint(parts[0], 10, 16)
+		_, lowErr := strconv.ParseUint(parts[1], 10, 16)
+		if highErr == nil && lowErr == nil {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid BGP Community",
+		fmt.Sprintf("Attribute %s %s (i.e. 65001:100), got: %q.", req.Path, v.Description(ctx), value),
+	)
+}
+
+// IsBgpCommunity returns a validator which ensures that the string is a valid BGP standard community.
+func IsBgpCommunity() validator.String {
+	return BgpCommunityValidator{}
+}
+
+var _ validator.String = RegexValidator{}
+
+// RegexValidator validates that a string is a valid regular expression.
+type RegexValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v RegexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v RegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v RegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := regexp.Compile(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Attribute %s %s, got: %q. Err: %s.", req.Path, v.Description(ctx), value, err),
+		)
+	}
+}
+
+// IsRegex returns a validator which ensures that the string is a valid regular expression.
+func IsRegex() validator.String {
+	return RegexValidator{}
+}
+
+var _ validator.String = ConnectionsCsvValidator{}
+
+// ConnectionsCsvValidator validates that a string is a valid CSV list of Connections.
+type ConnectionsCsvValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v ConnectionsCsvValidator) Description(_ context.Context) string {
+	return "value must be a CSV list of Connections with a local_node, local_port, remote_node and remote_port header"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v ConnectionsCsvValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v ConnectionsCsvValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseConnectionsCsv(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Connections CSV",
+			fmt.Sprintf("Attribute %s %s. Err: %s.", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// IsConnectionsCsv returns a validator which ensures that the string is a valid CSV list of Connections.
+func IsConnectionsCsv() validator.String {
+	return ConnectionsCsvValidator{}
+}
+
+var _ validator.String = IpAddressOrHostnameValidator{}
+
+// IpAddressOrHostnameValidator validates that a string is either an IP host
+// address or a valid DNS hostname.
+type IpAddressOrHostnameValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v IpAddressOrHostnameValidator) Description(_ context.Context) string {
+	return "value must be an IP address or a hostname"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v IpAddressOrHostnameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v IpAddressOrHostnameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := netip.ParseAddr(value); err == nil {
+		return
+	}
+
+	// A value only made of digits and dots is a malformed IPv4 address rather than a hostname.
+	if strings.Trim(value, "0123456789.") == "" || strings.Contains(value, ":") || len(value) > 253 || !hostnameRegex.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address or Hostname",
+			fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsIpAddressOrHostname returns a validator which ensures that the string is an IP address or a hostname.
+func IsIpAddressOrHostname() validator.String {
+	return IpAddressOrHostnameValidator{}
+}
+
+var _ validator.String = GatewayInSubnetValidator{}
+
+// GatewayInSubnetValidator validates that a gateway is a host address inside the
+// subnet of the address in CIDR notation configured in the AddressPath attribute.
+type GatewayInSubnetValidator struct {
+	Family      string
+	AddressPath path.Path
+}
+
+// Description describes the validation in plain text formatting.
+func (v GatewayInSubnetValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be an %s address inside the subnet of %s", ipFamilyName(v.Family), v.AddressPath)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v GatewayInSubnetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v GatewayInSubnetValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	// Validate the gateway itself before comparing it with the subnet.
+	addressResp := &validator.StringResponse{}
+	IpAddressValidator{Family: v.Family}.ValidateString(ctx, req, addressResp)
+	resp.Diagnostics.Append(addressResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var address types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.AddressPath, &address)...)
+	if resp.Diagnostics.HasError() || address.IsNull() || address.IsUnknown() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(address.ValueString())
+	if err != nil {
+		// The address attribute reports its own format error.
+		return
+	}
+
+	gateway, _ := netip.ParseAddr(req.ConfigValue.ValueString())
+	if !prefix.Masked().Contains(gateway) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Gateway Address",
+			fmt.Sprintf("Attribute %s value %q is not inside the subnet %s of %s.", req.Path, gateway, prefix.Masked(), v.AddressPath),
+		)
+	} else if gateway == prefix.Addr() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Gateway Address",
+			fmt.Sprintf("Attribute %s value %q must be different from the address configured in %s.", req.Path, gateway, v.AddressPath),
+		)
+	}
+}
+
+// IsIpv4GatewayInSubnet returns a validator which ensures that the string is an IPv4 address inside the subnet of addressPath.
+func IsIpv4GatewayInSubnet(addressPath path.Path) validator.String {
+	return GatewayInSubnetValidator{Family: ipFamilyIpv4, AddressPath: addressPath}
+}
+
+// IsIpv6GatewayInSubnet returns a validator which ensures that the string is an IPv6 address inside the subnet of addressPath.
+func IsIpv6GatewayInSubnet(addressPath path.Path) validator.String {
+	return GatewayInSubnetValidator{Family: ipFamilyIpv6, AddressPath: addressPath}
+}
+
+var _ validator.String = PortNameValidator{}
+
+// PortNameValidator validates that a string follows the Node Port naming
+// grammar `Ethernet<Slot>_<Port>` or `Ethernet<Slot>_<Port>_<Breakout Port>`.
+// When AllowPortChannel is set, Port Channel names `PortChannel<Number>` are
+// also accepted.
+type PortNameValidator struct {
+	AllowWildcard    bool
+	AllowPortChannel bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v PortNameValidator) Description(_ context.Context) string {
+	formats := "the Ethernet<Slot>_<Port> or Ethernet<Slot>_<Port>_<Breakout Port> format"
+	if v.AllowPortChannel {
+		formats = "the Ethernet<Slot>_<Port>, Ethernet<Slot>_<Port>_<Breakout Port> or PortChannel<Number> format"
+	}
+	if v.AllowWildcard {
+		return fmt.Sprintf("value must be \"*\" or a port name in %s", formats)
+	}
+	return fmt.Sprintf("value must be a port name in %s", formats)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v PortNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v PortNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if v.AllowWildcard && value == "*" {
+		return
+	}
+
+	if v.AllowPortChannel && isValidPortChannelName(value) {
+		return
+	}
+
+	if !isValidPortName(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port Name",
+			fmt.Sprintf("Attribute %s %s (i.e. Ethernet1_10 or Ethernet1_1_1), got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+func isValidPortName(name string) bool {
+	matches := portNameRegex.FindStringSubmatch(name)
+	if matches == nil {
+		return false
+	}
+	for _, index := range matches[1:] {
+		if strings.HasPrefix(index, "0") {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidPortChannelName returns true when the name follows the `PortChannel<Number>` grammar with a number
+// between 1 and 4096.
+func isValidPortChannelName(name string) bool {
+	matches := portChannelNameRegex.FindStringSubmatch(name)
+	if matches == nil || strings.HasPrefix(matches[1], "0") {
+		return false
+	}
+	number, err := strconv.Atoi(matches[1])
+	return err == nil && number >= 1 && number <= 4096
+}
+
+// IsPortName returns a validator which ensures that the string is a valid Node Port name.
+func IsPortName() validator.String {
+	return PortNameValidator{}
+}
+
+// IsPortNameOrPortChannelOrWildcard returns a validator which ensures that the string is a valid Node Port name,
+// a valid Port Channel name or "*".
+func IsPortNameOrPortChannelOrWildcard() validator.String {
+	return PortNameValidator{AllowWildcard: true, AllowPortChannel: true}
+}
+
+var _ validator.String = PortChannelNameValidator{}
+
+// PortChannelNameValidator validates that a string follows the Port Channel
+// naming grammar `PortChannel<Number>` with a number between 1 and 4096.
+type PortChannelNameValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v PortChannelNameValidator) Description(_ context.Context) string {
+	return "value must be a Port Channel name in the PortChannel<Number> format with a number between 1 and 4096"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v PortChannelNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v PortChannelNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !isValidPortChannelName(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port Channel Name",
+			fmt.Sprintf("Attribute %s %s (i.e. PortChannel10), got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// IsPortChannelName returns a validator which ensures that the string is a valid Port Channel name.
+func IsPortChannelName() validator.String {
+	return PortChannelNameValidator{}
+}
+
+var _ validator.String = SubInterfaceNameValidator{}
+
+// SubInterfaceNameValidator validates that a string follows the Sub-Interface
+// naming grammar `<Port Name>.<Integer>`. When the VLAN ID attribute found at
+// VlanIdPath is not configured, the integer is used as the encapsulation VLAN
+// ID and must therefore be a valid VLAN ID.
+type SubInterfaceNameValidator struct {
+	VlanIdPath path.Path
+}
+
+// Description describes the validation in plain text formatting.
+func (v SubInterfaceNameValidator) Description(_ context.Context) string {
+	return "value must be a Sub-Interface name in the <Port Name>.<Integer> format"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v SubInterfaceNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v SubInterfaceNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	matches := subInterfaceNameRegex.FindStringSubmatch(value)
+	if matches == nil || !isValidPortName(matches[1]) || strings.HasPrefix(matches[2], "0") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Sub-Interface Name",
+			fmt.Sprintf("Attribute %s %s (i.e. Ethernet1_1.100), got: %q.", req.Path, v.Description(ctx), value),
+		)
+		return
+	}
+
+	var vlanId types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.VlanIdPath, &vlanId)...)
+	if resp.Diagnostics.HasError() || !vlanId.IsNull() {
+		return
+	}
+
+	if subInterfaceId, err := strconv.Atoi(matches[2]); err != nil || subInterfaceId < 1 || subInterfaceId > 4094 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Sub-Interface Name",
+			fmt.Sprintf("Attribute %s integer suffix must be between 1 and 4094 when %s is not configured as it is used as the encapsulation VLAN ID, got: %q.", req.Path, v.VlanIdPath, value),
+		)
+	}
+}
+
+// IsSubInterfaceName returns a validator which ensures that the string is a valid Sub-Interface name.
+func IsSubInterfaceName(vlanIdPath path.Path) validator.String {
+	return SubInterfaceNameValidator{VlanIdPath: vlanIdPath}
+}
+
+var _ validator.String = BgpUpdateSourceValidator{}
+
+// BgpUpdateSourceValidator validates that a string is the name of a Loopback
+// `Loopback<Number>` or of a Sub-Interface `<Port Name>.<Integer>` of a Node.
+type BgpUpdateSourceValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v BgpUpdateSourceValidator) Description(_ context.Context) string {
+	return "value must be a Loopback name in the Loopback<Number> format or a Sub-Interface name in the <Port Name>.<Integer> format"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v BgpUpdateSourceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v BgpUpdateSourceValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if matches := loopbackNameRegex.FindStringSubmatch(value); matches != nil && !strings.HasPrefix(matches[1], "0") {
+		return
+	}
+	if matches := subInterfaceNameRegex.FindStringSubmatch(value); matches != nil && isValidPortName(matches[1]) && !strings.HasPrefix(matches[2], "0") {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid BGP Update Source",
+		fmt.Sprintf("Attribute %s %s (i.e. Loopback10 or Ethernet1_10.100), got: %q.", req.Path, v.Description(ctx), value),
+	)
+}
+
+// IsBgpUpdateSource returns a validator which ensures that the string is a valid Loopback or Sub-Interface name.
+func IsBgpUpdateSource() validator.String {
+	return BgpUpdateSourceValidator{}
+}
+
+// nodeModelPortLayout describes the breakout capabilities of the front panel ports of a Node model.
+type nodeModelPortLayout struct {
+	Slot             int
+	BreakoutPorts    [2]int
+	MaxBreakoutPorts int
+}
+
+var nodeModelPortLayouts = map[string]nodeModelPortLayout{
+	// 32 x 400G QSFP-DD ports, all of them can be broken out.
+	"HF6100-32D": {Slot: 1, BreakoutPorts: [2]int{1, 32}, MaxBreakoutPorts: 8},
+	// 60 x 50G SFP56 ports and 4 x 400G QSFP-DD ports, only the QSFP-DD ports can be broken out.
+	"HF6100-60L4D": {Slot: 1, BreakoutPorts: [2]int{61, 64}, MaxBreakoutPorts: 8},
+}
+
+// validateBreakoutPortForNodeModel returns an error when the port cannot be broken out on the Node model, or when
+// the Breakout Port cannot exist on the Node model. When breakout is false, the port is not one of the ports of the
+// Node and must be a Breakout Port, a port which has been broken out is rejected. Unknown models are otherwise ignored.
+func validateBreakoutPortForNodeModel(modelName, portName string, breakout bool) error {
+	layout, ok := nodeModelPortLayouts[modelName]
+	matches := portNameRegex.FindStringSubmatch(portName)
+	if matches == nil {
+		return nil
+	}
+	if !breakout && matches[3] == "" {
+		return fmt.Errorf("port %q has been broken out, use one of its Breakout Ports instead (i.e. %s_1)", portName, portName)
+	}
+	if !ok {
+		return nil
+	}
+
+	slot, _ := strconv.Atoi(matches[1])
+	port, _ := strconv.Atoi(matches[2])
+	if !breakout && (slot != layout.Slot || port < layout.BreakoutPorts[0] || port > layout.BreakoutPorts[1]) {
+		return fmt.Errorf("port %q cannot be a Breakout Port on a %s Node, only ports Ethernet%d_%d to Ethernet%d_%d can be broken out", portName, modelName, layout.Slot, layout.BreakoutPorts[0], layout.Slot, layout.BreakoutPorts[1])
+	}
+	if breakout && (slot != layout.Slot || port < layout.BreakoutPorts[0] || port > layout.BreakoutPorts[1] || matches[3] != "") {
+		return fmt.Errorf("port %q cannot be broken out on a %s Node, only ports Ethernet%d_%d to Ethernet%d_%d can be broken out", portName, modelName, layout.Slot, layout.BreakoutPorts[0], layout.Slot, layout.BreakoutPorts[1])
+	}
+
+	if breakoutPort, _ := strconv.Atoi(matches[3]); matches[3] != "" && (breakoutPort < 1 || breakoutPort > layout.MaxBreakoutPorts) {
+		return fmt.Errorf("port %q does not exist on a %s Node, a port can be broken out in at most %d Breakout Ports", portName, modelName, layout.MaxBreakoutPorts)
+	}
+	return nil
+}
+
+// isNodePortName returns true when the port exists in the ports of the Node, or when it is a Breakout Port of a port
+// of the Node. A port which has been broken out is replaced by its Breakout Ports in the ports of the Node.
+func isNodePortName(ports map[string]map[string]interface{}, portName string) bool {
+	if _, ok := ports[portName]; ok {
+		return true
+	}
+
+	if matches := portNameRegex.FindStringSubmatch(portName); matches != nil && matches[3] != "" {
+		portName = fmt.Sprintf("Ethernet%s_%s", matches[1], matches[2])
+		if _, ok := ports[portName]; ok {
+			return true
+		}
+	}
+	for name := range ports {
+		if strings.HasPrefix(name, portName+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPortNamesForNodeModel adds an attribute error for each port name that does not exist on the Node, or that
+// cannot be broken out when breakout is true. The format of the port names is validated by the schema validators.
+// The ports of the Node are retrieved from the API, while the breakout capabilities of the ports are only known for
+// the models of nodeModelPortLayouts, so a warning is added for other models. The Node is only retrieved when its id
+// is known, so the check is skipped when the Node is created in the same plan.
+func checkPortNamesForNodeModel(ctx context.Context, diags *diag.Diagnostics, client *client.Client, nodeId types.String, attributePath path.Path, portNames []string, breakout bool) {
+	if client == nil || nodeId.IsNull() || nodeId.IsUnknown() || len(portNames) == 0 {
+		return
+	}
+
+	var requestDiags diag.Diagnostics
+	ports, found := getNodePortsAttributesMap(ctx, &requestDiags, client, nodeId.ValueString())
+	if requestDiags.HasError() || !found || len(ports) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to retrieve the ports of Node '%s'", nodeId.ValueString()))
+		return
+	}
+
+	modelName, modelRetrieved := "", false
+	for _, portName := range portNames {
+		if !isNodePortName(ports, portName) {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Port Name for Node Model",
+				fmt.Sprintf("The port %q does not exist on the Node.", portName),
+			)
+			continue
+		}
+
+		// Existing ports are valid unless they are broken out, Breakout Ports may be created by a Breakout in the same plan
+		if _, ok := ports[portName]; ok && !breakout {
+			continue
+		}
+		if !modelRetrieved {
+			modelName, modelRetrieved = getNodeModelName(ctx, client, nodeId.ValueString()), true
+			if _, ok := nodeModelPortLayouts[modelName]; !ok && modelName != "" {
+				diags.AddAttributeWarning(
+					attributePath,
+					"Unknown Node Model",
+					fmt.Sprintf("The breakout capabilities of the %s Node model are not known by the provider, the Breakout Ports are only validated when the plan is applied.", modelName),
+				)
+			}
+		}
+		if err := validateBreakoutPortForNodeModel(modelName, portName, breakout); err != nil {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid Port Name for Node Model",
+				fmt.Sprintf("The %s.", err),
+			)
+		}
+	}
+}
+
+// getNodeModelName returns the model name of the Node with the provided id in the {fabricId}/nodes/{nodeId} format.
+// An empty string is returned when the Node cannot be retrieved, for example because it has not been created yet.
+func getNodeModelName(ctx context.Context, client *client.Client, nodeId string) string {
+	if client == nil {
+		return ""
+	}
+
+	var requestDiags diag.Diagnostics
+	requestData := DoRestRequest(ctx, &requestDiags, client, fmt.Sprintf("/api/v1/fabrics/%s", nodeId), "GET", nil)
+	if requestDiags.HasError() || requestData == nil || requestData.Data() == nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to retrieve the model name of Node '%s'", nodeId))
+		return ""
+	}
+
+	if attributes, ok := requestData.Data().(map[string]interface{}); ok {
+		if modelName, ok := attributes["modelName"].(string); ok {
+			return modelName
+		}
+	}
+	return ""
+}
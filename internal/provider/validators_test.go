@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPortNameValidators(t *testing.T) {
+	for _, test := range []struct {
+		validator validator.String
+		value     string
+		valid     bool
+	}{
+		{IsPortName(), "Ethernet1_10", true},
+		{IsPortName(), "Ethernet1_1_1", true},
+		{IsPortName(), "Ethernet1_01", false},
+		{IsPortName(), "Ethernet1", false},
+		{IsPortName(), "ethernet1_1", false},
+		{IsPortName(), "*", false},
+		{IsPortName(), "PortChannel1", false},
+		{IsPortNameOrPortChannelOrWildcard(), "*", true},
+		{IsPortNameOrPortChannelOrWildcard(), "PortChannel4096", true},
+		{IsPortNameOrPortChannelOrWildcard(), "PortChannel4097", false},
+		{IsPortNameOrPortChannelOrWildcard(), "Ethernet1_1_1", true},
+		{IsPortChannelName(), "PortChannel1", true},
+		{IsPortChannelName(), "PortChannel0", false},
+		{IsPortChannelName(), "PortChannel01", false},
+		{IsPortChannelName(), "Ethernet1_1", false},
+	} {
+		resp := &validator.StringResponse{}
+		test.validator.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("name"),
+			ConfigValue: types.StringValue(test.value),
+		}, resp)
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("expected %q to be valid: %t with %T, got: %v", test.value, test.valid, test.validator, resp.Diagnostics)
+		}
+	}
+}
+
+func TestValidateBreakoutPortForNodeModel(t *testing.T) {
+	for _, test := range []struct {
+		modelName string
+		portName  string
+		breakout  bool
+		valid     bool
+	}{
+		// Ports broken out by a Breakout
+		{"HF6100-32D", "Ethernet1_1", true, true},
+		{"HF6100-32D", "Ethernet1_32", true, true},
+		{"HF6100-32D", "Ethernet1_33", true, false},
+		{"HF6100-32D", "Ethernet2_1", true, false},
+		{"HF6100-32D", "Ethernet1_1_1", true, false},
+		{"HF6100-60L4D", "Ethernet1_61", true, true},
+		{"HF6100-60L4D", "Ethernet1_60", true, false},
+		// Breakout Ports referenced by a Port or a Sub-Interface
+		{"HF6100-32D", "Ethernet1_1_1", false, true},
+		{"HF6100-32D", "Ethernet1_1_8", false, true},
+		{"HF6100-32D", "Ethernet1_1_9", false, false},
+		{"HF6100-32D", "Ethernet1_1_0", false, false},
+		{"HF6100-60L4D", "Ethernet1_1_1", false, false},
+		{"HF6100-60L4D", "Ethernet1_64_4", false, true},
+		// Ports which have been broken out
+		{"HF6100-32D", "Ethernet1_1", false, false},
+		{"HF6100-60L4D", "Ethernet1_61", false, false},
+		{"UNKNOWN", "Ethernet1_1", false, false},
+		// Unknown models
+		{"UNKNOWN", "Ethernet1_1", true, true},
+		{"UNKNOWN", "Ethernet9_99_99", false, true},
+		{"", "Ethernet1_1_1", false, true},
+	} {
+		err := validateBreakoutPortForNodeModel(test.modelName, test.portName, test.breakout)
+		if (err == nil) != test.valid {
+			t.Errorf("expected port %q of a %s Node with breakout %t to be valid: %t, got: %v", test.portName, test.modelName, test.breakout, test.valid, err)
+		}
+	}
+}
+
+func TestIsNodePortName(t *testing.T) {
+	// Ports of a Node on which Ethernet1_2 has been broken out
+	ports := map[string]map[string]interface{}{
+		"Ethernet1_1":   {},
+		"Ethernet1_2_1": {},
+		"Ethernet1_2_2": {},
+		"Ethernet1_10":  {},
+	}
+
+	for portName, expected := range map[string]bool{
+		"Ethernet1_1":   true,
+		"Ethernet1_10":  true,
+		"Ethernet1_2":   true,
+		"Ethernet1_2_1": true,
+		"Ethernet1_2_3": true,
+		"Ethernet1_1_1": true,
+		"Ethernet1_3":   false,
+		"Ethernet1_3_1": false,
+		"Ethernet2_1":   false,
+	} {
+		if isNodePortName(ports, portName) != expected {
+			t.Errorf("expected port %q to exist on the Node: %t", portName, expected)
+		}
+	}
+}
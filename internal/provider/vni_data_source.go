@@ -73,19 +73,24 @@ func (r *VniDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "The Id of the VRF associated with the VNI.",
 				Computed:            true,
 			},
-			"mtu": schema.Float64Attribute{
+			"mtu": schema.Int64Attribute{
 				MarkdownDescription: "The MTU of the SVI of the VNI.",
 				Computed:            true,
 			},
-			"vni": schema.Float64Attribute{
+			"vni": schema.Int64Attribute{
 				MarkdownDescription: "The VXLAN Network Identifier (VNI) used for the VNI.",
 				Computed:            true,
 			},
-			"members":     getMembersDataSourceSchemaAttribute(),
-			"svi":         getSviDataSourceSchemaAttribute(),
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"members": getMembersDataSourceSchemaAttribute(),
+			"ignore_undeclared_members": schema.BoolAttribute{
+				MarkdownDescription: "The `ignore_undeclared_members` setting is only available in the resource configuration.",
+				Computed:            true,
+			},
+			"svi":                    getSviDataSourceSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_vni")
@@ -0,0 +1,464 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VniMemberResource{}
+var _ resource.ResourceWithImportState = &VniMemberResource{}
+var _ resource.ResourceWithModifyPlan = &VniMemberResource{}
+
+func NewVniMemberResource() resource.Resource {
+	return &VniMemberResource{}
+}
+
+// VniMemberResource defines the resource implementation.
+type VniMemberResource struct {
+	client *client.Client
+}
+
+// VniMemberResourceModel describes the resource data model.
+type VniMemberResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	VniId    types.String `tfsdk:"vni_id"`
+	NodeId   types.String `tfsdk:"node_id"`
+	NodeName types.String `tfsdk:"node_name"`
+	PortName types.String `tfsdk:"port_name"`
+	VlanId   types.Int64  `tfsdk:"vlan_id"`
+	Untagged types.Bool   `tfsdk:"untagged"`
+}
+
+// getMemberFromVniMember returns the VNI member represented by the data.
+func getMemberFromVniMember(data *VniMemberResourceModel) MemberResourceModel {
+	member := *getEmptyMemberResourceModel()
+	member.NodeId = data.NodeId
+	member.PortName = data.PortName
+	member.Untagged = basetypes.NewBoolValue(data.Untagged.ValueBool())
+	if !data.Untagged.ValueBool() {
+		member.VlanId = data.VlanId
+	}
+	return member
+}
+
+// getVniMemberId returns the id of the VNI member in the {fabricId}/vnis/{vniId}/members/{nodeId}/{portName}/{vlanId|untagged} format.
+func getVniMemberId(vniId string, member MemberResourceModel) string {
+	return fmt.Sprintf("%s/members/%s", vniId, getMemberKey(member))
+}
+
+func checkAndSetVniMemberIds(data *VniMemberResourceModel) {
+	if strings.Contains(data.Id.ValueString(), "/members/") {
+		splitId := strings.SplitN(data.Id.ValueString(), "/members/", 2)
+		memberKey := strings.Split(splitId[1], "/")
+		if len(memberKey) != 3 {
+			return
+		}
+		if data.VniId.IsNull() || data.VniId.IsUnknown() || data.VniId.ValueString() == "" {
+			data.VniId = basetypes.NewStringValue(splitId[0])
+		}
+		if data.NodeId.IsNull() || data.NodeId.IsUnknown() || data.NodeId.ValueString() == "" {
+			data.NodeId = basetypes.NewStringValue(memberKey[0])
+		}
+		if data.PortName.IsNull() || data.PortName.IsUnknown() || data.PortName.ValueString() == "" {
+			data.PortName = basetypes.NewStringValue(memberKey[1])
+		}
+		if memberKey[2] == "untagged" {
+			data.Untagged = basetypes.NewBoolValue(true)
+		} else if data.VlanId.IsNull() || data.VlanId.IsUnknown() {
+			var vlanId int64
+			if _, err := fmt.Sscanf(memberKey[2], "%d", &vlanId); err == nil {
+				data.VlanId = basetypes.NewInt64Value(vlanId)
+			}
+		}
+	}
+}
+
+func (r *VniMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData, stateData *VniMemberResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if planData.Untagged.IsUnknown() {
+			planData.Untagged = basetypes.NewBoolValue(false)
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+		}
+
+		if !planData.VlanId.IsUnknown() {
+			if planData.VlanId.IsNull() && !planData.Untagged.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("vlan_id"),
+					"Missing VLAN ID",
+					"The vlan_id attribute must be set when the member is not untagged.",
+				)
+				return
+			} else if !planData.VlanId.IsNull() && planData.Untagged.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("vlan_id"),
+					"Invalid VLAN ID",
+					"The vlan_id attribute cannot be set when the member is untagged.",
+				)
+				return
+			}
+		}
+
+		// Query the Fabric for conflicting members only when the member is planned to be created
+		if stateData == nil && !planData.VniId.IsUnknown() && strings.Contains(planData.VniId.ValueString(), "/vnis/") {
+			splitId := strings.Split(planData.VniId.ValueString(), "/vnis/")
+			checkVniMembersConsistency(ctx, &resp.Diagnostics, r.client, splitId[0], splitId[1], []MemberResourceModel{getMemberFromVniMember(planData)})
+		}
+	}
+}
+
+func (r *VniMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_vni_member")
+	resp.TypeName = req.ProviderTypeName + "_vni_member"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_vni_member")
+}
+
+func (r *VniMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_vni_member")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "VNI Member resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a member of a VNI in a Fabric.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vni_id": schema.StringAttribute{
+				MarkdownDescription: "`vni_id` defines the unique identifier of a VNI in a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				MarkdownDescription: `The unique Id of a node in the Fabric or "*" for all nodes.`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: `The name of a node in the Fabric.`,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port_name": schema.StringAttribute{
+				MarkdownDescription: `The name of the port or port channel, or "*" for all ports on a node or all nodes.`,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					IsPortNameOrPortChannelOrWildcard(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: `The VLAN ID used as encapsulation for the traffic on this port for this VNI.`,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"untagged": schema.BoolAttribute{
+				MarkdownDescription: `The untagged state for the traffic on this port for this VNI.`,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_vni_member")
+}
+
+func (r *VniMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_vni_member")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_vni_member")
+}
+
+func (r *VniMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_vni_member")
+
+	var data *VniMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member := getMemberFromVniMember(data)
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_vni_member in VNI '%s' with member '%s'", data.VniId.ValueString(), getMemberKey(member)))
+
+	found := updateVniMembers(ctx, &resp.Diagnostics, r.client, data.VniId.ValueString(), func(members []MemberResourceModel) []MemberResourceModel {
+		for _, existingMember := range members {
+			if getMemberKey(existingMember) == getMemberKey(member) {
+				return members
+			}
+		}
+		return append(members, member)
+	})
+	if !resp.Diagnostics.HasError() && !found {
+		resp.Diagnostics.AddError(
+			"VNI not found",
+			fmt.Sprintf("The VNI '%s' has not been found.", data.VniId.ValueString()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(getVniMemberId(data.VniId.ValueString(), member))
+	getAndSetVniMemberAttributes(ctx, &resp.Diagnostics, r.client, data)
+	if !resp.Diagnostics.HasError() && data.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to create hyperfabric_vni_member",
+			fmt.Sprintf("The member '%s' has not been found in the VNI '%s' after it was added.", getMemberKey(member), data.VniId.ValueString()),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_vni_member with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VniMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_vni_member")
+	var data *VniMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_vni_member with id '%s'", data.Id.ValueString()))
+	checkAndSetVniMemberIds(data)
+	getAndSetVniMemberAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *VniMemberResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_vni_member with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VniMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_vni_member")
+	var data *VniMemberResourceModel
+
+	// All the configurable attributes require a replacement, so only the plan is saved into the state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_vni_member with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VniMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_vni_member")
+	var data *VniMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_vni_member with id '%s'", data.Id.ValueString()))
+	checkAndSetVniMemberIds(data)
+	memberKey := getMemberKey(getMemberFromVniMember(data))
+
+	// A VNI which no longer exists has no members left to remove
+	updateVniMembers(ctx, &resp.Diagnostics, r.client, data.VniId.ValueString(), func(members []MemberResourceModel) []MemberResourceModel {
+		remainingMembers := make([]MemberResourceModel, 0)
+		for _, member := range members {
+			if getMemberKey(member) != memberKey {
+				remainingMembers = append(remainingMembers, member)
+			}
+		}
+		return remainingMembers
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_vni_member with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VniMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_vni_member")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *VniMemberResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_vni_member with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_vni_member")
+}
+
+// getVniWithAttributes retrieves the VNI with the provided id in the {fabricId}/vnis/{vniId} format and returns the
+// VNI with all its members together with its raw attributes. The id of the VNI is null when the VNI is not found.
+func getVniWithAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, vniId string) (*VniResourceModel, map[string]interface{}) {
+	vni := getEmptyVniResourceModel()
+	vni.Id = basetypes.NewStringValue(vniId)
+	checkAndSetVniIds(vni)
+
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", vni.FabricId.ValueString(), vni.VniId.ValueString()), "GET", nil)
+	if diags.HasError() {
+		return vni, nil
+	}
+
+	var attributes map[string]interface{}
+	if requestData.Data() != nil {
+		attributes, _ = requestData.Data().(map[string]interface{})
+	}
+	setVniAttributes(ctx, vni, attributes)
+	return vni, attributes
+}
+
+// updateVniMembers retrieves the VNI, applies updateFunc to its members and updates the VNI. The other attributes of
+// the VNI, including its labels and annotations, are sent back unchanged. The VNI is locked during the update to avoid
+// losing the members of other hyperfabric_vni_member resources of the same VNI updated in parallel. False is returned
+// when the VNI does not exist.
+func updateVniMembers(ctx context.Context, diags *diag.Diagnostics, client *client.Client, vniId string, updateFunc func([]MemberResourceModel) []MemberResourceModel) bool {
+	lockVni := getEmptyVniResourceModel()
+	lockVni.Id = basetypes.NewStringValue(vniId)
+	checkAndSetVniIds(lockVni)
+	defer lockVniMembers(client, lockVni.FabricId.ValueString(), lockVni.VniId.ValueString())()
+
+	vni, attributes := getVniWithAttributes(ctx, diags, client, vniId)
+	if diags.HasError() || vni.Id.IsNull() {
+		return !vni.Id.IsNull()
+	}
+
+	members := make([]MemberResourceModel, 0)
+	if !vni.Members.IsNull() && !vni.Members.IsUnknown() {
+		diags.Append(vni.Members.ElementsAs(ctx, &members, false)...)
+		if diags.HasError() {
+			return true
+		}
+	}
+
+	var setDiags diag.Diagnostics
+	vni.Members, setDiags = types.SetValueFrom(ctx, MemberResourceModelAttributeType(), updateFunc(members))
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return true
+	}
+
+	jsonPayload := getVniJsonPayload(ctx, diags, vni, "update")
+	if diags.HasError() {
+		return true
+	}
+
+	// The labels and annotations of the VNI are not managed by this resource
+	if labels, ok := attributes["labels"]; ok {
+		jsonPayload.Set(labels, "labels")
+	}
+	if annotations, ok := attributes["annotations"]; ok {
+		jsonPayload.Set(annotations, "annotations")
+	}
+
+	DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", vni.FabricId.ValueString(), vni.VniId.ValueString()), "PUT", jsonPayload)
+	return true
+}
+
+func getAndSetVniMemberAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *VniMemberResourceModel) {
+	vni, _ := getVniWithAttributes(ctx, diags, client, data.VniId.ValueString())
+	if diags.HasError() {
+		return
+	}
+
+	if vni.Id.IsNull() {
+		data.Id = basetypes.NewStringNull()
+		return
+	}
+
+	members := make([]MemberResourceModel, 0)
+	if !vni.Members.IsNull() && !vni.Members.IsUnknown() {
+		diags.Append(vni.Members.ElementsAs(ctx, &members, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	memberKey := getMemberKey(getMemberFromVniMember(data))
+	for _, member := range members {
+		if getMemberKey(member) == memberKey {
+			data.Id = basetypes.NewStringValue(getVniMemberId(data.VniId.ValueString(), member))
+			data.NodeId = member.NodeId
+			data.NodeName = member.NodeName
+			data.PortName = member.PortName
+			data.Untagged = member.Untagged
+			if !member.VlanId.IsNull() {
+				data.VlanId = member.VlanId
+			}
+			return
+		}
+	}
+	data.Id = basetypes.NewStringNull()
+}
@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVniMemberResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that a member without VLAN ID and not untagged is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Member - Validate that a member without VLAN ID and not untagged is rejected during plan.")
+				},
+				Config:      testVniMemberResourceHclConfig(fabricName, "invalid"),
+				ExpectError: regexp.MustCompile(`Missing VLAN ID`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Member - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testVniMemberResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hyperfabric_vni_member.test", "vni_id", "hyperfabric_vni.test", "id"),
+					resource.TestCheckResourceAttrPair("hyperfabric_vni_member.test", "node_id", "hyperfabric_node.test", "node_id"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test", "node_name", "node1"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test", "port_name", "Ethernet1_10"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test", "vlan_id", "103"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test", "untagged", "false"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.0.port_name", "Ethernet1_9"),
+				),
+			},
+			// Run Plan Only with minimal config and check that the VNI does not remove the undeclared member.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Member - Run Plan Only with minimal config and check that the VNI does not remove the undeclared member.")
+				},
+				Config:             testVniMemberResourceHclConfig(fabricName, "minimal"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Member - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:      "hyperfabric_vni_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Member - Update with all config and verify provided values.")
+				},
+				Config:             testVniMemberResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test", "port_name", "Ethernet1_10"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test", "vlan_id", "103"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test_untagged", "port_name", "Ethernet1_11"),
+					resource.TestCheckResourceAttr("hyperfabric_vni_member.test_untagged", "untagged", "true"),
+					resource.TestCheckNoResourceAttr("hyperfabric_vni_member.test_untagged", "vlan_id"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testVniMemberResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_vni" "test" {
+	fabric_id                 = hyperfabric_fabric.test.id
+	name                      = "Vni1"
+	ignore_undeclared_members = true
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_9"
+			vlan_id   = 103
+		}
+	]
+}
+`, fabricName)
+
+	if configType == "full" {
+		return base + `
+resource "hyperfabric_vni_member" "test" {
+	vni_id    = hyperfabric_vni.test.id
+	node_id   = hyperfabric_node.test.node_id
+	port_name = "Ethernet1_10"
+	vlan_id   = 103
+}
+
+resource "hyperfabric_vni_member" "test_untagged" {
+	vni_id    = hyperfabric_vni.test.id
+	node_id   = hyperfabric_node.test.node_id
+	port_name = "Ethernet1_11"
+	untagged  = true
+}
+`
+	} else if configType == "invalid" {
+		return base + `
+resource "hyperfabric_vni_member" "test" {
+	vni_id    = hyperfabric_vni.test.id
+	node_id   = hyperfabric_node.test.node_id
+	port_name = "Ethernet1_10"
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_vni_member" "test" {
+	vni_id    = hyperfabric_vni.test.id
+	node_id   = hyperfabric_node.test.node_id
+	port_name = "Ethernet1_10"
+	vlan_id   = 103
+}
+`
+	}
+}
@@ -15,14 +15,17 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	customTypes "github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/provider/custom_types"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -31,6 +34,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VniResource{}
 var _ resource.ResourceWithImportState = &VniResource{}
+var _ resource.ResourceWithUpgradeState = &VniResource{}
 
 func NewVniResource() resource.Resource {
 	return &VniResource{}
@@ -51,14 +55,16 @@ type VniResourceModel struct {
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	IsDefault   types.Bool   `tfsdk:"is_default"`
 	// IsL3        types.Bool    `tfsdk:"is_l3"`
-	VrfId       customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
-	Vni         types.Float64                     `tfsdk:"vni"`
-	Mtu         types.Float64                     `tfsdk:"mtu"`
-	Members     types.Set                         `tfsdk:"members"`
-	Svi         types.Object                      `tfsdk:"svi"`
-	Metadata    types.Object                      `tfsdk:"metadata"`
-	Labels      types.Set                         `tfsdk:"labels"`
-	Annotations types.Set                         `tfsdk:"annotations"`
+	VrfId                   customTypes.UuidFromIdStringValue `tfsdk:"vrf_id"`
+	Vni                     types.Int64                       `tfsdk:"vni"`
+	Mtu                     types.Int64                       `tfsdk:"mtu"`
+	Members                 types.Set                         `tfsdk:"members"`
+	IgnoreUndeclaredMembers types.Bool                        `tfsdk:"ignore_undeclared_members"`
+	Svi                     types.Object                      `tfsdk:"svi"`
+	Metadata                types.Object                      `tfsdk:"metadata"`
+	Labels                  types.Set                         `tfsdk:"labels"`
+	Annotations             types.Set                         `tfsdk:"annotations"`
+	IgnoreExternalLabels    types.Bool                        `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyVniResourceModel() *VniResourceModel {
@@ -71,14 +77,16 @@ func getEmptyVniResourceModel() *VniResourceModel {
 		Enabled:     basetypes.NewBoolNull(),
 		IsDefault:   basetypes.NewBoolNull(),
 		// IsL3:        basetypes.NewBoolNull(),
-		VrfId:       customTypes.NewUuidFromIdStringNull(),
-		Vni:         basetypes.NewFloat64Null(),
-		Mtu:         basetypes.NewFloat64Null(),
-		Members:     basetypes.NewSetNull(MemberResourceModelAttributeType()),
-		Svi:         basetypes.NewObjectNull(SviResourceModelAttributeType()),
-		Metadata:    basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		VrfId:                   customTypes.NewUuidFromIdStringNull(),
+		Vni:                     basetypes.NewInt64Null(),
+		Mtu:                     basetypes.NewInt64Null(),
+		Members:                 basetypes.NewSetNull(MemberResourceModelAttributeType()),
+		IgnoreUndeclaredMembers: basetypes.NewBoolNull(),
+		Svi:                     basetypes.NewObjectNull(SviResourceModelAttributeType()),
+		Metadata:                basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:                  basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:             basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels:    basetypes.NewBoolNull(),
 	}
 }
 
@@ -129,6 +137,10 @@ func getNewVniResourceModelFromData(data *VniResourceModel) *VniResourceModel {
 		newVni.Members = data.Members
 	}
 
+	if !data.IgnoreUndeclaredMembers.IsNull() && !data.IgnoreUndeclaredMembers.IsUnknown() {
+		newVni.IgnoreUndeclaredMembers = data.IgnoreUndeclaredMembers
+	}
+
 	if !data.Svi.IsNull() && !data.Svi.IsUnknown() {
 		newVni.Svi = data.Svi
 	}
@@ -145,6 +157,10 @@ func getNewVniResourceModelFromData(data *VniResourceModel) *VniResourceModel {
 		newVni.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newVni.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newVni
 }
 
@@ -180,6 +196,23 @@ func (r *VniResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 			}
 		}
 
+		if !planData.Members.IsNull() && !planData.Members.IsUnknown() {
+			planData.Members = getPlannedMembersUntaggedSet(ctx, &resp.Diagnostics, planData.Members)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Query the Fabric for conflicting members only when the members are planned to change
+		if !planData.Members.IsNull() && !planData.Members.IsUnknown() && !planData.FabricId.IsUnknown() && (stateData == nil || !stateData.Members.Equal(planData.Members)) {
+			members := []MemberResourceModel{}
+			planData.Members.ElementsAs(ctx, &members, false)
+			checkVniMembersConsistency(ctx, &resp.Diagnostics, r.client, planData.FabricId.ValueString(), planData.VniId.ValueString(), members)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
 	}
 }
@@ -195,6 +228,7 @@ func (r *VniResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "VNI resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -271,35 +305,53 @@ func (r *VniResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					CompareUuidWithIdForEquality(),
 				},
 			},
-			"vni": schema.Float64Attribute{
+			"vni": schema.Int64Attribute{
 				MarkdownDescription: "The VXLAN Network Identifier (VNI) used for the VRF.",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.RequiresReplace(),
-					float64planmodifier.UseStateForUnknown(),
-					SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+					SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16777214),
 				},
 			},
-			"mtu": schema.Float64Attribute{
+			"mtu": schema.Int64Attribute{
 				MarkdownDescription: "The MTU of the SVI of the VNI.",
 				// Optional:            true,
 				Computed: true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
-					SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
-			"members":     getMembersSchemaAttribute(),
-			"svi":         getSviSchemaAttribute(),
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"members": getMembersSchemaAttribute(),
+			"ignore_undeclared_members": schema.BoolAttribute{
+				MarkdownDescription: "Ignore the members of the VNI that are not declared in `members`, such as the members managed by the `hyperfabric_vni_member` resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"svi":                    getSviSchemaAttribute(),
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_vni")
 }
 
+func (r *VniResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeFloat64StateToInt64,
+		},
+	}
+}
+
 func (r *VniResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: hyperfabric_vni")
 	// Prevent panic if the provider has not been configured.
@@ -410,6 +462,25 @@ func (r *VniResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", data.FabricId.ValueString(), data.VniId.ValueString()), "hyperfabric_vni", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", data.FabricId.ValueString(), data.VniId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IgnoreUndeclaredMembers.ValueBool() {
+		// The VNI stays locked until the update is sent to keep the members added by hyperfabric_vni_member resources
+		defer lockVniMembers(r.client, data.FabricId.ValueString(), data.VniId.ValueString())()
+		preserveUndeclaredMembers(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", data.FabricId.ValueString(), data.VniId.ValueString()), jsonPayload, stateData.Members, data.Members)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", data.FabricId.ValueString(), data.VniId.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -436,6 +507,11 @@ func (r *VniResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_vni with id '%s'", data.Id.ValueString()))
 	checkAndSetVniIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", data.FabricId.ValueString(), data.VniId.ValueString()), "hyperfabric_vni", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vnis/%s", data.FabricId.ValueString(), data.VniId.ValueString()), "DELETE", nil)
 	if resp.Diagnostics.HasError() {
 		return
@@ -458,10 +534,19 @@ func getAndSetVniAttributes(ctx context.Context, diags *diag.Diagnostics, client
 		return
 	}
 
+	var attributes map[string]interface{}
+	if requestData.Data() != nil {
+		attributes = requestData.Data().(map[string]interface{})
+	}
+	setVniAttributes(ctx, data, attributes)
+}
+
+// setVniAttributes sets the data with the attributes of a VNI returned by the API. The id of the data is set to null
+// when no attributes are provided.
+func setVniAttributes(ctx context.Context, data *VniResourceModel, attributes map[string]interface{}) {
 	newVni := *getNewVniResourceModelFromData(data)
 
-	if requestData.Data() != nil {
-		attributes := requestData.Data().(map[string]interface{})
+	if attributes != nil {
 		for attributeName, attributeValue := range attributes {
 			if attributeName == "fabricId" && (data.FabricId.IsNull() || data.FabricId.IsUnknown() || data.FabricId.ValueString() == "" || data.FabricId.ValueString() != attributeValue.(string)) {
 				newVni.FabricId = basetypes.NewStringValue(attributeValue.(string))
@@ -482,9 +567,9 @@ func getAndSetVniAttributes(ctx context.Context, diags *diag.Diagnostics, client
 			} else if attributeName == "vrfId" {
 				newVni.VrfId = customTypes.NewUuidFromIdStringValue(attributeValue.(string))
 			} else if attributeName == "vni" {
-				newVni.Vni = basetypes.NewFloat64Value(attributeValue.(float64))
+				newVni.Vni = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "mtu" {
-				newVni.Mtu = basetypes.NewFloat64Value(attributeValue.(float64))
+				newVni.Mtu = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "members" {
 				stateMembers := make([]MemberResourceModel, 0)
 				data.Members.ElementsAs(ctx, &stateMembers, false)
@@ -494,11 +579,15 @@ func getAndSetVniAttributes(ctx context.Context, diags *diag.Diagnostics, client
 			} else if attributeName == "metadata" {
 				newVni.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newVni.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newVni.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newVni.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newVni.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
+		// Only the declared members are stored in the state when the undeclared members are ignored
+		if newVni.IgnoreUndeclaredMembers.ValueBool() && !data.Members.IsNull() && !data.Members.IsUnknown() {
+			newVni.Members = getDeclaredMembersSet(ctx, newVni.Members, data.Members)
+		}
 	} else {
 		newVni.Id = basetypes.NewStringNull()
 	}
@@ -539,11 +628,11 @@ func getVniJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *VniRe
 	}
 
 	if !data.Vni.IsNull() && !data.Vni.IsUnknown() {
-		payloadMap["vni"] = data.Vni.ValueFloat64()
+		payloadMap["vni"] = data.Vni.ValueInt64()
 	}
 
 	if !data.Mtu.IsNull() && !data.Mtu.IsUnknown() {
-		payloadMap["mtu"] = data.Mtu.ValueFloat64()
+		payloadMap["mtu"] = data.Mtu.ValueInt64()
 	}
 
 	if !data.Members.IsNull() && !data.Members.IsUnknown() {
@@ -558,7 +647,7 @@ func getVniJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *VniRe
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
@@ -7,9 +7,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -395,3 +401,156 @@ resource "hyperfabric_vni" "test" {
 `, fabricName, name, vniConfigLine)
 	}
 }
+
+func TestAccVniResourceUntaggedMembers(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that a member with both a VLAN ID and untagged set to true is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Untagged Members - Validate that a member with both a VLAN ID and untagged set to true is rejected during plan.")
+				},
+				Config:      testVniResourceUntaggedMembersHclConfig(fabricName, "invalid"),
+				ExpectError: regexp.MustCompile(`Invalid VNI Member`),
+			},
+			// Create with an untagged member and verify provided and default values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Untagged Members - Create with an untagged member and verify provided and default values.")
+				},
+				Config:             testVniResourceUntaggedMembersHclConfig(fabricName, "untagged"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.0.port_name", "Ethernet1_10"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.0.vlan_id", "103"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.0.untagged", "false"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.1.port_name", "Ethernet1_11"),
+					resource.TestCheckNoResourceAttr("hyperfabric_vni.test", "members.1.vlan_id"),
+					resource.TestCheckResourceAttr("hyperfabric_vni.test", "members.1.untagged", "true"),
+				),
+			},
+			// Validate that a port cannot be an untagged member of a second VNI.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VNI Untagged Members - Validate that a port cannot be an untagged member of a second VNI.")
+				},
+				Config:      testVniResourceUntaggedMembersHclConfig(fabricName, "duplicate"),
+				ExpectError: regexp.MustCompile(`Duplicate Untagged VNI`),
+			},
+		},
+	})
+}
+
+func testVniResourceUntaggedMembersHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+`, fabricName)
+
+	if configType == "invalid" {
+		return base + `
+resource "hyperfabric_vni" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vni1"
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_11"
+			vlan_id   = 103
+			untagged  = true
+		}
+	]
+}
+`
+	}
+
+	config := base + `
+resource "hyperfabric_vni" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vni1"
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_10"
+			vlan_id   = 103
+		},
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_11"
+		}
+	]
+}
+`
+	if configType == "duplicate" {
+		config += `
+resource "hyperfabric_vni" "test2" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vni2"
+	members = [
+		{
+			node_id   = hyperfabric_node.test.node_id
+			port_name = "Ethernet1_11"
+			untagged  = true
+		}
+	]
+}
+`
+	}
+	return config
+}
+
+func TestVniResourceUpgradeStateFromVersion0(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	NewVniResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	// Prior state of schema version 0 where the whole numbers were stored as Float64 attributes
+	req := fwresource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"fab1/vnis/vni1","fabric_id":"fab1","vni_id":"vni1","name":"vni1","vni":1001.0,"mtu":9216.4,"members":[{"port_name":"Ethernet1_1","node_id":"fab1/nodes/node1","node_name":"leaf1","vlan_id":101.0,"untagged":false}]}`),
+		},
+	}
+	resp := &fwresource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgradeFloat64StateToInt64(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected the state to be upgraded, got: %v", resp.Diagnostics)
+	}
+
+	var data *VniResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected the upgraded state to match the schema, got: %v", resp.Diagnostics)
+	}
+	if data.Vni.ValueInt64() != 1001 {
+		t.Errorf("expected vni to be upgraded to 1001, got: %s", data.Vni)
+	}
+	if data.Mtu.ValueInt64() != 9216 {
+		t.Errorf("expected mtu to be truncated to 9216, got: %s", data.Mtu)
+	}
+
+	members := []MemberResourceModel{}
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() || len(members) != 1 {
+		t.Fatalf("expected a single member in the upgraded state, got: %v", data.Members)
+	}
+	if members[0].VlanId.ValueInt64() != 101 {
+		t.Errorf("expected vlan_id of the member to be upgraded to 101, got: %s", members[0].VlanId)
+	}
+}
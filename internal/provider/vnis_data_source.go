@@ -0,0 +1,241 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VnisDataSource{}
+
+func NewVnisDataSource() datasource.DataSource {
+	return &VnisDataSource{}
+}
+
+// VnisDataSource defines the data source implementation.
+type VnisDataSource struct {
+	client *client.Client
+}
+
+// VnisDataSourceModel describes the data source data model.
+type VnisDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Vnis        types.List   `tfsdk:"vnis"`
+}
+
+// VniSummaryDataSourceModel describes a VNI in the list of VNIs.
+type VniSummaryDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	VniId       types.String `tfsdk:"vni_id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	VrfId       types.String `tfsdk:"vrf_id"`
+	Vni         types.Int64  `tfsdk:"vni"`
+	Mtu         types.Int64  `tfsdk:"mtu"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Set    `tfsdk:"annotations"`
+}
+
+func VniSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":          types.StringType,
+			"vni_id":      types.StringType,
+			"fabric_id":   types.StringType,
+			"name":        types.StringType,
+			"description": types.StringType,
+			"enabled":     types.BoolType,
+			"is_default":  types.BoolType,
+			"vrf_id":      types.StringType,
+			"vni":         types.Int64Type,
+			"mtu":         types.Int64Type,
+			"labels":      types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"annotations": types.SetType{ElemType: AnnotationResourceModelAttributeType()},
+		},
+	}
+}
+
+func (d *VnisDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_vnis")
+	resp.TypeName = req.ProviderTypeName + "_vnis"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_vnis")
+}
+
+func (d *VnisDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_vnis")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "VNIs data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/vnis`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"name_regex":  getNameRegexFilterSchemaAttribute("VNIs"),
+			"labels":      getLabelsFilterSchemaAttribute("VNIs"),
+			"annotations": getAnnotationsFilterSchemaAttribute("VNIs"),
+			"enabled":     getEnabledFilterSchemaAttribute("VNIs"),
+			"vnis": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of VNIs matching the filters sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a VNI in a Fabric.",
+							Computed:            true,
+						},
+						"vni_id": schema.StringAttribute{
+							MarkdownDescription: "`vni_id` defines the unique identifier of a VNI.",
+							Computed:            true,
+						},
+						"fabric_id": schema.StringAttribute{
+							MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the VNI.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the VNI.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The enabled admin state of the VNI.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "The flag that denote if the VNI is the default VNI or not.",
+							Computed:            true,
+						},
+						"vrf_id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the VRF associated with the VNI.",
+							Computed:            true,
+						},
+						"vni": schema.Int64Attribute{
+							MarkdownDescription: "The VXLAN Network Identifier of the VNI.",
+							Computed:            true,
+						},
+						"mtu": schema.Int64Attribute{
+							MarkdownDescription: "The MTU of the VNI.",
+							Computed:            true,
+						},
+						"labels":      getLabelsDataSourceSchemaAttribute(),
+						"annotations": getAnnotationsDataSourceSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_vnis")
+}
+
+func (d *VnisDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_vnis")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_vnis")
+}
+
+func (d *VnisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_vnis")
+	var data *VnisDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/vnis", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_vnis with id '%s'", data.Id.ValueString()))
+
+	filters := getObjectFilters(ctx, data.NameRegex, data.Labels, data.Annotations, basetypes.NewSetNull(types.StringType), data.Enabled)
+	vnis := getVnisList(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString(), filters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Vnis, _ = types.ListValueFrom(ctx, VniSummaryDataSourceModelAttributeType(), vnis)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_vnis with id '%s'", data.Id.ValueString()))
+}
+
+// getVnisList returns the VNIs of the Fabric matching the filters sorted by name.
+func getVnisList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId string, filters ObjectFilters) []VniSummaryDataSourceModel {
+	vnis := make([]VniSummaryDataSourceModel, 0)
+	for _, vni := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/vnis", fabricId), "vnis") {
+		if !filters.matches(vni, "name") {
+			continue
+		}
+
+		vniFabricId := getStringFromMap(vni, "fabricId")
+		if vniFabricId == "" {
+			vniFabricId = fabricId
+		}
+		vnis = append(vnis, VniSummaryDataSourceModel{
+			Id:          basetypes.NewStringValue(fmt.Sprintf("%s/vnis/%s", vniFabricId, getStringFromMap(vni, "id"))),
+			VniId:       basetypes.NewStringValue(getStringFromMap(vni, "id")),
+			FabricId:    basetypes.NewStringValue(vniFabricId),
+			Name:        basetypes.NewStringValue(getStringFromMap(vni, "name")),
+			Description: basetypes.NewStringValue(getStringFromMap(vni, "description")),
+			Enabled:     basetypes.NewBoolValue(vni["enabled"] == true),
+			IsDefault:   basetypes.NewBoolValue(vni["isDefault"] == true),
+			VrfId:       basetypes.NewStringValue(getStringFromMap(vni, "vrfId")),
+			Vni:         basetypes.NewInt64Value(getInt64FromMap(vni, "vni")),
+			Mtu:         basetypes.NewInt64Value(getInt64FromMap(vni, "mtu")),
+			Labels:      getObjectLabelsSet(ctx, vni),
+			Annotations: getObjectAnnotationsSet(ctx, vni),
+		})
+	}
+
+	sort.SliceStable(vnis, func(i, j int) bool {
+		return vnis[i].Name.ValueString() < vnis[j].Name.ValueString()
+	})
+	return vnis
+}
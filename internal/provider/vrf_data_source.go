@@ -13,6 +13,7 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -69,11 +70,11 @@ func (r *VrfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "The flag that denote if the VRF is the default VRF or not.",
 				Computed:            true,
 			},
-			"asn": schema.Float64Attribute{
+			"asn": schema.Int64Attribute{
 				MarkdownDescription: "The Autonomous System Number (ASN) used for the VRF external connections.",
 				Computed:            true,
 			},
-			"vni": schema.Float64Attribute{
+			"vni": schema.Int64Attribute{
 				MarkdownDescription: "The VXLAN Network Identifier (VNI) used for the VRF.",
 				Computed:            true,
 			},
@@ -81,9 +82,28 @@ func (r *VrfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "The route target associated with the VRF.",
 				Computed:            true,
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsDataSourceSchemaAttribute(),
-			"annotations": getAnnotationsDataSourceSchemaAttribute(),
+			"import_route_targets": schema.SetAttribute{
+				MarkdownDescription: "A set of route targets of which the routes are imported in the VRF.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"export_route_targets": schema.SetAttribute{
+				MarkdownDescription: "A set of route targets attached to the routes exported from the VRF.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"import_route_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The `route_policy_id` of a Route Policy filtering the routes imported in the VRF.",
+				Computed:            true,
+			},
+			"export_route_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The `route_policy_id` of a Route Policy filtering the routes exported from the VRF.",
+				Computed:            true,
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: hyperfabric_vrf")
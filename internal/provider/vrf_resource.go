@@ -14,14 +14,17 @@ import (
 
 	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
 	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,6 +33,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VrfResource{}
 var _ resource.ResourceWithImportState = &VrfResource{}
+var _ resource.ResourceWithUpgradeState = &VrfResource{}
 
 func NewVrfResource() resource.Resource {
 	return &VrfResource{}
@@ -42,36 +46,46 @@ type VrfResource struct {
 
 // VrfResourceModel describes the resource data model.
 type VrfResourceModel struct {
-	Id          types.String  `tfsdk:"id"`
-	VrfId       types.String  `tfsdk:"vrf_id"`
-	FabricId    types.String  `tfsdk:"fabric_id"`
-	Name        types.String  `tfsdk:"name"`
-	Description types.String  `tfsdk:"description"`
-	Enabled     types.Bool    `tfsdk:"enabled"`
-	IsDefault   types.Bool    `tfsdk:"is_default"`
-	Asn         types.Float64 `tfsdk:"asn"`
-	Vni         types.Float64 `tfsdk:"vni"`
-	RouteTarget types.String  `tfsdk:"route_target"`
-	Metadata    types.Object  `tfsdk:"metadata"`
-	Labels      types.Set     `tfsdk:"labels"`
-	Annotations types.Set     `tfsdk:"annotations"`
+	Id                   types.String `tfsdk:"id"`
+	VrfId                types.String `tfsdk:"vrf_id"`
+	FabricId             types.String `tfsdk:"fabric_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	IsDefault            types.Bool   `tfsdk:"is_default"`
+	Asn                  types.Int64  `tfsdk:"asn"`
+	Vni                  types.Int64  `tfsdk:"vni"`
+	RouteTarget          types.String `tfsdk:"route_target"`
+	ImportRouteTargets   types.Set    `tfsdk:"import_route_targets"`
+	ExportRouteTargets   types.Set    `tfsdk:"export_route_targets"`
+	ImportRoutePolicyId  types.String `tfsdk:"import_route_policy_id"`
+	ExportRoutePolicyId  types.String `tfsdk:"export_route_policy_id"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
 }
 
 func getEmptyVrfResourceModel() *VrfResourceModel {
 	return &VrfResourceModel{
-		Id:          basetypes.NewStringNull(),
-		VrfId:       basetypes.NewStringNull(),
-		FabricId:    basetypes.NewStringNull(),
-		Name:        basetypes.NewStringNull(),
-		Description: basetypes.NewStringNull(),
-		Enabled:     basetypes.NewBoolNull(),
-		IsDefault:   basetypes.NewBoolNull(),
-		Asn:         basetypes.NewFloat64Null(),
-		Vni:         basetypes.NewFloat64Null(),
-		RouteTarget: basetypes.NewStringNull(),
-		Metadata:    basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
-		Labels:      basetypes.NewSetNull(SetStringResourceModelAttributeType()),
-		Annotations: basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		Id:                   basetypes.NewStringNull(),
+		VrfId:                basetypes.NewStringNull(),
+		FabricId:             basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Enabled:              basetypes.NewBoolNull(),
+		IsDefault:            basetypes.NewBoolNull(),
+		Asn:                  basetypes.NewInt64Null(),
+		Vni:                  basetypes.NewInt64Null(),
+		RouteTarget:          basetypes.NewStringNull(),
+		ImportRouteTargets:   basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		ExportRouteTargets:   basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		ImportRoutePolicyId:  basetypes.NewStringNull(),
+		ExportRoutePolicyId:  basetypes.NewStringNull(),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
 	}
 }
 
@@ -118,6 +132,22 @@ func getNewVrfResourceModelFromData(data *VrfResourceModel) *VrfResourceModel {
 		newVrf.RouteTarget = data.RouteTarget
 	}
 
+	if !data.ImportRouteTargets.IsNull() && !data.ImportRouteTargets.IsUnknown() {
+		newVrf.ImportRouteTargets = data.ImportRouteTargets
+	}
+
+	if !data.ExportRouteTargets.IsNull() && !data.ExportRouteTargets.IsUnknown() {
+		newVrf.ExportRouteTargets = data.ExportRouteTargets
+	}
+
+	if !data.ImportRoutePolicyId.IsNull() && !data.ImportRoutePolicyId.IsUnknown() {
+		newVrf.ImportRoutePolicyId = data.ImportRoutePolicyId
+	}
+
+	if !data.ExportRoutePolicyId.IsNull() && !data.ExportRoutePolicyId.IsUnknown() {
+		newVrf.ExportRoutePolicyId = data.ExportRoutePolicyId
+	}
+
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 		newVrf.Metadata = data.Metadata
 	}
@@ -130,6 +160,10 @@ func getNewVrfResourceModelFromData(data *VrfResourceModel) *VrfResourceModel {
 		newVrf.Annotations = data.Annotations
 	}
 
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newVrf.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
 	return newVrf
 }
 
@@ -148,6 +182,7 @@ func (r *VrfResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "VRF resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -201,22 +236,28 @@ func (r *VrfResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					SetToBoolNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
-			"asn": schema.Float64Attribute{
+			"asn": schema.Int64Attribute{
 				MarkdownDescription: "The Autonomous System Number (ASN) used for the VRF external connections.",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
-					SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
 				},
 			},
-			"vni": schema.Float64Attribute{
+			"vni": schema.Int64Attribute{
 				MarkdownDescription: "The VXLAN Network Identifier (VNI) used for the VRF.",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers: []planmodifier.Float64{
-					float64planmodifier.UseStateForUnknown(),
-					SetToFloat64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					SetToInt64NullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16777214),
 				},
 			},
 			"route_target": schema.StringAttribute{
@@ -227,14 +268,47 @@ func (r *VrfResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
 				},
 			},
-			"metadata":    getMetadataSchemaAttribute(),
-			"labels":      getLabelsSchemaAttribute(),
-			"annotations": getAnnotationsSchemaAttribute(),
+			"import_route_targets": schema.SetAttribute{
+				MarkdownDescription: "A set of route targets of which the routes are imported in the VRF.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IsRouteTarget()),
+				},
+			},
+			"export_route_targets": schema.SetAttribute{
+				MarkdownDescription: "A set of route targets attached to the routes exported from the VRF.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(IsRouteTarget()),
+				},
+			},
+			"import_route_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The `route_policy_id` of a Route Policy filtering the routes imported in the VRF.",
+				Optional:            true,
+			},
+			"export_route_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The `route_policy_id` of a Route Policy filtering the routes exported from the VRF.",
+				Optional:            true,
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
 		},
 	}
 	tflog.Debug(ctx, "End schema of resource: hyperfabric_vrf")
 }
 
+func (r *VrfResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeFloat64StateToInt64,
+		},
+	}
+}
+
 func (r *VrfResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: hyperfabric_vrf")
 	// Prevent panic if the provider has not been configured.
@@ -344,6 +418,16 @@ func (r *VrfResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs/%s", data.FabricId.ValueString(), data.VrfId.ValueString()), "hyperfabric_vrf", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs/%s", data.FabricId.ValueString(), data.VrfId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs/%s", data.FabricId.ValueString(), data.VrfId.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -370,6 +454,11 @@ func (r *VrfResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_vrf with id '%s'", data.Id.ValueString()))
 	checkAndSetVrfIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs/%s", data.FabricId.ValueString(), data.VrfId.ValueString()), "hyperfabric_vrf", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs/%s", data.FabricId.ValueString(), data.VrfId.ValueString()), "DELETE", nil)
 	if resp.Diagnostics.HasError() {
 		return
@@ -412,17 +501,34 @@ func getAndSetVrfAttributes(ctx context.Context, diags *diag.Diagnostics, client
 			} else if attributeName == "isDefault" {
 				newVrf.IsDefault = basetypes.NewBoolValue(attributeValue.(bool))
 			} else if attributeName == "asn" {
-				newVrf.Asn = basetypes.NewFloat64Value(attributeValue.(float64))
+				newVrf.Asn = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "vni" {
-				newVrf.Vni = basetypes.NewFloat64Value(attributeValue.(float64))
+				newVrf.Vni = basetypes.NewInt64Value(int64(attributeValue.(float64)))
 			} else if attributeName == "routeTarget" {
 				newVrf.RouteTarget = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "importRouteTargets" {
+				// Empty route targets and route policies are returned when not set, they are only stored when configured
+				if len(attributeValue.([]interface{})) > 0 || !newVrf.ImportRouteTargets.IsNull() {
+					newVrf.ImportRouteTargets = NewSetString(ctx, attributeValue.([]interface{}))
+				}
+			} else if attributeName == "exportRouteTargets" {
+				if len(attributeValue.([]interface{})) > 0 || !newVrf.ExportRouteTargets.IsNull() {
+					newVrf.ExportRouteTargets = NewSetString(ctx, attributeValue.([]interface{}))
+				}
+			} else if attributeName == "importRoutePolicyId" {
+				if attributeValue.(string) != "" || !newVrf.ImportRoutePolicyId.IsNull() {
+					newVrf.ImportRoutePolicyId = basetypes.NewStringValue(attributeValue.(string))
+				}
+			} else if attributeName == "exportRoutePolicyId" {
+				if attributeValue.(string) != "" || !newVrf.ExportRoutePolicyId.IsNull() {
+					newVrf.ExportRoutePolicyId = basetypes.NewStringValue(attributeValue.(string))
+				}
 			} else if attributeName == "metadata" {
 				newVrf.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
 			} else if attributeName == "labels" {
-				newVrf.Labels = NewSetString(ctx, attributeValue.([]interface{}))
+				newVrf.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
 			} else if attributeName == "annotations" {
-				newVrf.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}))
+				newVrf.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
 			}
 		}
 	} else {
@@ -455,18 +561,42 @@ func getVrfJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *VrfRe
 	// }
 
 	if !data.Asn.IsNull() && !data.Asn.IsUnknown() {
-		payloadMap["asn"] = data.Asn.ValueFloat64()
+		payloadMap["asn"] = data.Asn.ValueInt64()
 	}
 
 	if !data.Vni.IsNull() && !data.Vni.IsUnknown() {
-		payloadMap["vni"] = data.Vni.ValueFloat64()
+		payloadMap["vni"] = data.Vni.ValueInt64()
+	}
+
+	if !data.ImportRouteTargets.IsNull() && !data.ImportRouteTargets.IsUnknown() {
+		payloadMap["importRouteTargets"] = getSetStringJsonPayload(ctx, data.ImportRouteTargets)
+	} else if action == "update" {
+		payloadMap["importRouteTargets"] = []string{}
+	}
+
+	if !data.ExportRouteTargets.IsNull() && !data.ExportRouteTargets.IsUnknown() {
+		payloadMap["exportRouteTargets"] = getSetStringJsonPayload(ctx, data.ExportRouteTargets)
+	} else if action == "update" {
+		payloadMap["exportRouteTargets"] = []string{}
+	}
+
+	if !data.ImportRoutePolicyId.IsNull() && !data.ImportRoutePolicyId.IsUnknown() {
+		payloadMap["importRoutePolicyId"] = data.ImportRoutePolicyId.ValueString()
+	} else if action == "update" {
+		payloadMap["importRoutePolicyId"] = ""
+	}
+
+	if !data.ExportRoutePolicyId.IsNull() && !data.ExportRoutePolicyId.IsUnknown() {
+		payloadMap["exportRoutePolicyId"] = data.ExportRoutePolicyId.ValueString()
+	} else if action == "update" {
+		payloadMap["exportRoutePolicyId"] = ""
 	}
 
 	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
 		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
 	}
 
-	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+	if isAnnotationsPayloadRequired(data.Annotations) {
 		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
 	}
 
@@ -44,6 +44,9 @@ func TestAccVrfResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "description", "This VRF is powered by Cisco Nexus Hyperfabric"),
 					// resource.TestCheckResourceAttr("hyperfabric_vrf.test", "asn", "65002"),
 					// resource.TestCheckResourceAttr("hyperfabric_vrf.test", "vni", "169"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "import_route_targets.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "export_route_targets.#", "1"),
+					resource.TestCheckTypeSetElemAttr("hyperfabric_vrf.test", "export_route_targets.*", "65000:100"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "labels.#", "2"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "annotations.#", "2"),
 				),
@@ -61,6 +64,9 @@ func TestAccVrfResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "description", "This VRF is powered by Cisco Nexus Hyperfabric"),
 					// resource.TestCheckResourceAttr("hyperfabric_vrf.test", "asn", "65002"),
 					// resource.TestCheckResourceAttr("hyperfabric_vrf.test", "vni", "169"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "import_route_targets.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "export_route_targets.#", "1"),
+					resource.TestCheckTypeSetElemAttr("hyperfabric_vrf.test", "export_route_targets.*", "65000:100"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "labels.#", "2"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "annotations.#", "2"),
 				),
@@ -96,6 +102,8 @@ func TestAccVrfResource(t *testing.T) {
 					// resource.TestCheckResourceAttr("hyperfabric_vrf.test", "asn", "65002"),
 					// resource.TestCheckResourceAttr("hyperfabric_vrf.test", "vni", "169"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "description", ""),
+					resource.TestCheckNoResourceAttr("hyperfabric_vrf.test", "import_route_targets.#"),
+					resource.TestCheckNoResourceAttr("hyperfabric_vrf.test", "export_route_targets.#"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "labels.#", "0"),
 					resource.TestCheckResourceAttr("hyperfabric_vrf.test", "annotations.#", "0"),
 				),
@@ -128,6 +136,13 @@ resource "hyperfabric_vrf" "test" {
 	name        = "%[2]s"
 	description = "This VRF is powered by Cisco Nexus Hyperfabric"
 	// asn         = 65002
+	import_route_targets = [
+		"65000:100",
+		"65000:200"
+	]
+	export_route_targets = [
+		"65000:100"
+	]
 	labels = [
 		"sj01-1-101-AAA01",
 		"blue"
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VrfStaticRouteDataSource{}
+
+func NewVrfStaticRouteDataSource() datasource.DataSource {
+	return &VrfStaticRouteDataSource{}
+}
+
+// VrfStaticRouteDataSource defines the data source implementation.
+type VrfStaticRouteDataSource struct {
+	client *client.Client
+}
+
+func (d *VrfStaticRouteDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_vrf_static_route")
+	resp.TypeName = req.ProviderTypeName + "_vrf_static_route"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_vrf_static_route")
+}
+
+func (d *VrfStaticRouteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_vrf_static_route")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "VRF Static Route data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a Static Route of a VRF in a Fabric.",
+				Computed:            true,
+			},
+			"vrf_id": schema.StringAttribute{
+				MarkdownDescription: "`vrf_id` defines the unique identifier of a VRF in a Fabric.",
+				Required:            true,
+			},
+			"static_route_id": schema.StringAttribute{
+				MarkdownDescription: "`static_route_id` defines the unique identifier of a Static Route of a VRF.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Static Route of the VRF.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the Static Route of the VRF.",
+				Computed:            true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "The destination IPv4 or IPv6 prefix of the Static Route in CIDR notation.",
+				Computed:            true,
+			},
+			"next_hops": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of next-hops used to forward the traffic matching the prefix of the Static Route.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The IP address of the next-hop.",
+							Computed:            true,
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "The name of the Port, Sub-Interface or Port Channel used to reach the next-hop.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"null0": schema.BoolAttribute{
+				MarkdownDescription: "Discard the traffic matching the prefix of the Static Route by sending it to the Null0 interface.",
+				Computed:            true,
+			},
+			"distance": schema.Int64Attribute{
+				MarkdownDescription: "The administrative distance of the Static Route.",
+				Computed:            true,
+			},
+			"node_ids": schema.SetAttribute{
+				MarkdownDescription: "A set of unique identifiers (nodeId) of the Nodes on which the Static Route is configured.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsDataSourceSchemaAttribute(),
+			"labels":                 getLabelsDataSourceSchemaAttribute(),
+			"annotations":            getAnnotationsDataSourceSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_vrf_static_route")
+}
+
+func (d *VrfStaticRouteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_vrf_static_route")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_vrf_static_route")
+}
+
+func (d *VrfStaticRouteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_vrf_static_route")
+	var data *VrfStaticRouteResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create a copy of the Id for when not found during getAndSetVrfStaticRouteAttributes
+	cachedId := data.Id.ValueString()
+	if cachedId == "" && data.Name.ValueString() != "" {
+		data.StaticRouteId = data.Name
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+
+	getAndSetVrfStaticRouteAttributes(ctx, &resp.Diagnostics, d.client, data)
+
+	if data.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Failed to read hyperfabric_vrf_static_route data source",
+			fmt.Sprintf("The hyperfabric_vrf_static_route data source with id '%s' has not been found", cachedId),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+}
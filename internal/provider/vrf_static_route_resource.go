@@ -0,0 +1,693 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VrfStaticRouteResource{}
+var _ resource.ResourceWithImportState = &VrfStaticRouteResource{}
+var _ resource.ResourceWithModifyPlan = &VrfStaticRouteResource{}
+
+func NewVrfStaticRouteResource() resource.Resource {
+	return &VrfStaticRouteResource{}
+}
+
+// VrfStaticRouteResource defines the resource implementation.
+type VrfStaticRouteResource struct {
+	client *client.Client
+}
+
+// VrfStaticRouteResourceModel describes the resource data model.
+type VrfStaticRouteResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	VrfId                types.String `tfsdk:"vrf_id"`
+	StaticRouteId        types.String `tfsdk:"static_route_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Prefix               types.String `tfsdk:"prefix"`
+	NextHops             types.Set    `tfsdk:"next_hops"`
+	Null0                types.Bool   `tfsdk:"null0"`
+	Distance             types.Int64  `tfsdk:"distance"`
+	NodeIds              types.Set    `tfsdk:"node_ids"`
+	Metadata             types.Object `tfsdk:"metadata"`
+	Labels               types.Set    `tfsdk:"labels"`
+	Annotations          types.Set    `tfsdk:"annotations"`
+	IgnoreExternalLabels types.Bool   `tfsdk:"ignore_external_labels"`
+}
+
+// VrfStaticRouteNextHopResourceModel describes a next-hop of a Static Route.
+type VrfStaticRouteNextHopResourceModel struct {
+	Address   types.String `tfsdk:"address"`
+	Interface types.String `tfsdk:"interface"`
+}
+
+// {
+// 	"address": "10.1.1.1",
+// 	"interface": "Ethernet1_10"
+// }
+
+func VrfStaticRouteNextHopResourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"address":   types.StringType,
+			"interface": types.StringType,
+		},
+	}
+}
+
+func getEmptyVrfStaticRouteResourceModel() *VrfStaticRouteResourceModel {
+	return &VrfStaticRouteResourceModel{
+		Id:                   basetypes.NewStringNull(),
+		VrfId:                basetypes.NewStringNull(),
+		StaticRouteId:        basetypes.NewStringNull(),
+		Name:                 basetypes.NewStringNull(),
+		Description:          basetypes.NewStringNull(),
+		Prefix:               basetypes.NewStringNull(),
+		NextHops:             basetypes.NewSetNull(VrfStaticRouteNextHopResourceModelAttributeType()),
+		Null0:                basetypes.NewBoolNull(),
+		Distance:             basetypes.NewInt64Null(),
+		NodeIds:              basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Metadata:             basetypes.NewObjectNull(MetadataResourceModelAttributeType()),
+		Labels:               basetypes.NewSetNull(SetStringResourceModelAttributeType()),
+		Annotations:          basetypes.NewSetNull(AnnotationResourceModelAttributeType()),
+		IgnoreExternalLabels: basetypes.NewBoolNull(),
+	}
+}
+
+func getNewVrfStaticRouteResourceModelFromData(data *VrfStaticRouteResourceModel) *VrfStaticRouteResourceModel {
+	newVrfStaticRoute := getEmptyVrfStaticRouteResourceModel()
+
+	if !data.Id.IsNull() && !data.Id.IsUnknown() {
+		newVrfStaticRoute.Id = data.Id
+	}
+
+	if !data.VrfId.IsNull() && !data.VrfId.IsUnknown() {
+		newVrfStaticRoute.VrfId = data.VrfId
+	}
+
+	if !data.StaticRouteId.IsNull() && !data.StaticRouteId.IsUnknown() {
+		newVrfStaticRoute.StaticRouteId = data.StaticRouteId
+	}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		newVrfStaticRoute.Name = data.Name
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		newVrfStaticRoute.Description = data.Description
+	}
+
+	if !data.Prefix.IsNull() && !data.Prefix.IsUnknown() {
+		newVrfStaticRoute.Prefix = data.Prefix
+	}
+
+	if !data.NextHops.IsNull() && !data.NextHops.IsUnknown() {
+		newVrfStaticRoute.NextHops = data.NextHops
+	}
+
+	if !data.Null0.IsNull() && !data.Null0.IsUnknown() {
+		newVrfStaticRoute.Null0 = data.Null0
+	}
+
+	if !data.Distance.IsNull() && !data.Distance.IsUnknown() {
+		newVrfStaticRoute.Distance = data.Distance
+	}
+
+	if !data.NodeIds.IsNull() && !data.NodeIds.IsUnknown() {
+		newVrfStaticRoute.NodeIds = data.NodeIds
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		newVrfStaticRoute.Metadata = data.Metadata
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		newVrfStaticRoute.Labels = data.Labels
+	}
+
+	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+		newVrfStaticRoute.Annotations = data.Annotations
+	}
+
+	if !data.IgnoreExternalLabels.IsNull() && !data.IgnoreExternalLabels.IsUnknown() {
+		newVrfStaticRoute.IgnoreExternalLabels = data.IgnoreExternalLabels
+	}
+
+	return newVrfStaticRoute
+}
+
+type VrfStaticRouteIdentifier struct {
+	Id types.String
+}
+
+func (r *VrfStaticRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var planData *VrfStaticRouteResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+		if resp.Diagnostics.HasError() || planData.NextHops.IsUnknown() || planData.Null0.IsUnknown() {
+			return
+		}
+
+		nextHops := []VrfStaticRouteNextHopResourceModel{}
+		resp.Diagnostics.Append(planData.NextHops.ElementsAs(ctx, &nextHops, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A Static Route either discards the traffic to Null0 or forwards it to at least one next-hop
+		if planData.Null0.ValueBool() && len(nextHops) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("next_hops"),
+				"Invalid Static Route Next-Hops",
+				"The next-hops of a Static Route cannot be configured when null0 is set to true.",
+			)
+			return
+		} else if !planData.Null0.ValueBool() && len(nextHops) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("next_hops"),
+				"Missing Static Route Next-Hops",
+				"At least one next-hop must be configured for a Static Route when null0 is not set to true.",
+			)
+			return
+		}
+
+		prefix, err := netip.ParsePrefix(planData.Prefix.ValueString())
+		for _, nextHop := range nextHops {
+			if nextHop.Address.IsUnknown() || nextHop.Interface.IsUnknown() {
+				continue
+			}
+			if nextHop.Address.IsNull() && nextHop.Interface.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("next_hops"),
+					"Invalid Static Route Next-Hop",
+					"Each next-hop of a Static Route must configure at least one of address or interface.",
+				)
+			} else if !nextHop.Address.IsNull() && err == nil {
+				address, addressErr := netip.ParseAddr(nextHop.Address.ValueString())
+				if addressErr == nil && address.Is4() != prefix.Addr().Is4() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("next_hops"),
+						"Invalid Static Route Next-Hop",
+						fmt.Sprintf("The next-hop address '%s' must belong to the same IP family as the prefix '%s'.", nextHop.Address.ValueString(), planData.Prefix.ValueString()),
+					)
+				}
+			}
+		}
+	}
+}
+
+func (r *VrfStaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: hyperfabric_vrf_static_route")
+	resp.TypeName = req.ProviderTypeName + "_vrf_static_route"
+	tflog.Debug(ctx, "End metadata of resource: hyperfabric_vrf_static_route")
+}
+
+func (r *VrfStaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: hyperfabric_vrf_static_route")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "VRF Static Route resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` defines the unique identifier of a Static Route of a VRF in a Fabric.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vrf_id": schema.StringAttribute{
+				MarkdownDescription: "`vrf_id` defines the unique identifier of a VRF in a Fabric.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"static_route_id": schema.StringAttribute{
+				MarkdownDescription: "`static_route_id` defines the unique identifier of a Static Route of a VRF.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Static Route of the VRF.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description is a user defined field to store notes about the Static Route of the VRF.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					SetToStringNullWhenStateIsNullPlanIsUnknownDuringUpdate(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "The destination IPv4 or IPv6 prefix of the Static Route in CIDR notation.",
+				Required:            true,
+				Validators: []validator.String{
+					IsIpPrefix(),
+				},
+			},
+			"next_hops": schema.SetNestedAttribute{
+				MarkdownDescription: "A set of next-hops used to forward the traffic matching the prefix of the Static Route.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The IP address of the next-hop.",
+							Optional:            true,
+							Validators: []validator.String{
+								IsIpAddress(),
+							},
+						},
+						"interface": schema.StringAttribute{
+							MarkdownDescription: "The name of the Port, Sub-Interface or Port Channel used to reach the next-hop.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"null0": schema.BoolAttribute{
+				MarkdownDescription: "Discard the traffic matching the prefix of the Static Route by sending it to the Null0 interface.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"distance": schema.Int64Attribute{
+				MarkdownDescription: "The administrative distance of the Static Route.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"node_ids": schema.SetAttribute{
+				MarkdownDescription: "A set of unique identifiers (nodeId) of the Nodes on which the Static Route is configured. The Static Route is configured on all the Nodes of the VRF when not provided.",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				ElementType: types.StringType,
+			},
+			"metadata":               getMetadataSchemaAttribute(),
+			"labels":                 getLabelsSchemaAttribute(),
+			"annotations":            getAnnotationsSchemaAttribute(),
+			"ignore_external_labels": getIgnoreExternalLabelsSchemaAttribute(),
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: hyperfabric_vrf_static_route")
+}
+
+func (r *VrfStaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: hyperfabric_vrf_static_route")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: hyperfabric_vrf_static_route")
+}
+
+func (r *VrfStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: hyperfabric_vrf_static_route")
+
+	var data *VrfStaticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create of resource hyperfabric_vrf_static_route with name '%s'", data.Name.ValueString()))
+
+	jsonPayload := getVrfStaticRouteJsonPayload(ctx, &resp.Diagnostics, data, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes", data.VrfId.ValueString()), "POST", jsonPayload)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staticRouteContainer, err := container.ArrayElement(0, "staticRoutes")
+	if err != nil {
+		return
+	}
+
+	staticRouteId := StripQuotes(staticRouteContainer.Search("id").String())
+	if staticRouteId != "" {
+		data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/staticRoutes/%s", data.VrfId.ValueString(), staticRouteId))
+		data.StaticRouteId = basetypes.NewStringValue(staticRouteId)
+		getAndSetVrfStaticRouteAttributes(ctx, &resp.Diagnostics, r.client, data)
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VrfStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: hyperfabric_vrf_static_route")
+	var data *VrfStaticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+	checkAndSetVrfStaticRouteIds(data)
+	getAndSetVrfStaticRouteAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *VrfStaticRouteResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VrfStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: hyperfabric_vrf_static_route")
+	var data *VrfStaticRouteResourceModel
+	var stateData *VrfStaticRouteResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+
+	jsonPayload := getVrfStaticRouteJsonPayload(ctx, &resp.Diagnostics, data, "update")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes/%s", data.VrfId.ValueString(), data.StaticRouteId.ValueString()), "hyperfabric_vrf_static_route", "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preserveExternalLabelsAndAnnotations(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes/%s", data.VrfId.ValueString(), data.StaticRouteId.ValueString()), jsonPayload, stateData.Labels, stateData.Annotations, data.IgnoreExternalLabels)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes/%s", data.VrfId.ValueString(), data.StaticRouteId.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetVrfStaticRouteAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End update of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VrfStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: hyperfabric_vrf_static_route")
+	var data *VrfStaticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+	checkAndSetVrfStaticRouteIds(data)
+	checkObjectOwnership(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes/%s", data.VrfId.ValueString(), data.StaticRouteId.ValueString()), "hyperfabric_vrf_static_route", "delete")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	DoRestRequest(ctx, &resp.Diagnostics, r.client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes/%s", data.VrfId.ValueString(), data.StaticRouteId.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource hyperfabric_vrf_static_route with id '%s'", data.Id.ValueString()))
+}
+
+func (r *VrfStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: hyperfabric_vrf_static_route")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var stateData *VrfStaticRouteResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource hyperfabric_vrf_static_route with id '%s'", stateData.Id.ValueString()))
+	tflog.Debug(ctx, "End import of state resource: hyperfabric_vrf_static_route with id")
+}
+
+func getAndSetVrfStaticRouteAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *VrfStaticRouteResourceModel) {
+	requestData := DoRestRequest(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/staticRoutes/%s", data.VrfId.ValueString(), data.StaticRouteId.ValueString()), "GET", nil)
+	if diags.HasError() {
+		return
+	}
+
+	newVrfStaticRoute := *getNewVrfStaticRouteResourceModelFromData(data)
+	fabricId, vrfId := splitVrfId(newVrfStaticRoute.VrfId.ValueString())
+
+	if requestData.Data() != nil {
+		attributes := requestData.Data().(map[string]interface{})
+		// The Static Route does not forward to Null0 unless reported otherwise
+		newVrfStaticRoute.Null0 = basetypes.NewBoolValue(false)
+		for attributeName, attributeValue := range attributes {
+			if attributeName == "id" && (data.StaticRouteId.IsNull() || data.StaticRouteId.IsUnknown() || data.StaticRouteId.ValueString() == "" || data.StaticRouteId.ValueString() != attributeValue.(string)) {
+				newVrfStaticRoute.StaticRouteId = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "fabricId" && fabricId != attributeValue.(string) {
+				fabricId = attributeValue.(string)
+			} else if attributeName == "vrfId" && vrfId != attributeValue.(string) {
+				vrfId = attributeValue.(string)
+			} else if attributeName == "name" {
+				newVrfStaticRoute.Name = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "description" {
+				newVrfStaticRoute.Description = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "prefix" {
+				newVrfStaticRoute.Prefix = basetypes.NewStringValue(attributeValue.(string))
+			} else if attributeName == "nextHops" {
+				newVrfStaticRoute.NextHops = NewVrfStaticRouteNextHopsSet(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "null0" {
+				newVrfStaticRoute.Null0 = basetypes.NewBoolValue(attributeValue.(bool))
+			} else if attributeName == "distance" {
+				newVrfStaticRoute.Distance = basetypes.NewInt64Value(int64(attributeValue.(float64)))
+			} else if attributeName == "nodes" {
+				newVrfStaticRoute.NodeIds = NewSetString(ctx, attributeValue.([]interface{}))
+			} else if attributeName == "metadata" {
+				newVrfStaticRoute.Metadata = NewMetadataObject(ctx, attributeValue.(map[string]interface{}))
+			} else if attributeName == "labels" {
+				newVrfStaticRoute.Labels = NewLabelsSet(ctx, attributeValue.([]interface{}), data.Labels, data.IgnoreExternalLabels)
+			} else if attributeName == "annotations" {
+				newVrfStaticRoute.Annotations = NewAnnotationsSet(ctx, attributeValue.([]interface{}), data.Annotations, data.IgnoreExternalLabels)
+			}
+		}
+		if fabricId != "" && vrfId != "" {
+			newVrfStaticRoute.VrfId = basetypes.NewStringValue(fmt.Sprintf("%s/vrfs/%s", fabricId, vrfId))
+		}
+		newVrfStaticRoute.Id = basetypes.NewStringValue(fmt.Sprintf("%s/staticRoutes/%s", newVrfStaticRoute.VrfId.ValueString(), newVrfStaticRoute.StaticRouteId.ValueString()))
+
+		// An empty list of next-hops or nodes is stored as null to match a configuration that omits them
+		if len(newVrfStaticRoute.NextHops.Elements()) == 0 {
+			newVrfStaticRoute.NextHops = basetypes.NewSetNull(VrfStaticRouteNextHopResourceModelAttributeType())
+		}
+		if len(newVrfStaticRoute.NodeIds.Elements()) == 0 {
+			newVrfStaticRoute.NodeIds = basetypes.NewSetNull(SetStringResourceModelAttributeType())
+		}
+	} else {
+		newVrfStaticRoute.Id = basetypes.NewStringNull()
+	}
+	*data = newVrfStaticRoute
+}
+
+func NewVrfStaticRouteNextHopsSet(ctx context.Context, requestData []interface{}) basetypes.SetValue {
+	nextHops := make([]VrfStaticRouteNextHopResourceModel, 0)
+	for _, nextHop := range requestData {
+		nextHopMap, ok := nextHop.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		newNextHop := VrfStaticRouteNextHopResourceModel{
+			Address:   basetypes.NewStringNull(),
+			Interface: basetypes.NewStringNull(),
+		}
+		if address := getStringFromMap(nextHopMap, "address"); address != "" {
+			newNextHop.Address = basetypes.NewStringValue(address)
+		}
+		if nextHopInterface := getStringFromMap(nextHopMap, "interface"); nextHopInterface != "" {
+			newNextHop.Interface = basetypes.NewStringValue(nextHopInterface)
+		}
+		nextHops = append(nextHops, newNextHop)
+	}
+	nextHopsSet, _ := types.SetValueFrom(ctx, VrfStaticRouteNextHopResourceModelAttributeType(), nextHops)
+	return nextHopsSet
+}
+
+func getVrfStaticRouteNextHopsJsonPayload(ctx context.Context, data basetypes.SetValue) []map[string]interface{} {
+	nextHops := []VrfStaticRouteNextHopResourceModel{}
+	data.ElementsAs(ctx, &nextHops, false)
+	nextHopPayloads := make([]map[string]interface{}, 0)
+	for _, nextHop := range nextHops {
+		nextHopPayload := map[string]interface{}{}
+		if !nextHop.Address.IsNull() && !nextHop.Address.IsUnknown() {
+			nextHopPayload["address"] = nextHop.Address.ValueString()
+		}
+		if !nextHop.Interface.IsNull() && !nextHop.Interface.IsUnknown() {
+			nextHopPayload["interface"] = nextHop.Interface.ValueString()
+		}
+		nextHopPayloads = append(nextHopPayloads, nextHopPayload)
+	}
+	return nextHopPayloads
+}
+
+func getVrfStaticRouteJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *VrfStaticRouteResourceModel, action string) *gabs.Container {
+	payloadMap := map[string]interface{}{}
+	payloadList := []map[string]interface{}{}
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		payloadMap["name"] = data.Name.ValueString()
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		payloadMap["description"] = data.Description.ValueString()
+	}
+
+	if !data.Prefix.IsNull() && !data.Prefix.IsUnknown() {
+		payloadMap["prefix"] = data.Prefix.ValueString()
+	}
+
+	payloadMap["nextHops"] = getVrfStaticRouteNextHopsJsonPayload(ctx, data.NextHops)
+
+	if !data.Null0.IsNull() && !data.Null0.IsUnknown() {
+		payloadMap["null0"] = data.Null0.ValueBool()
+	}
+
+	if !data.Distance.IsNull() && !data.Distance.IsUnknown() {
+		payloadMap["distance"] = data.Distance.ValueInt64()
+	}
+
+	if !data.NodeIds.IsNull() && !data.NodeIds.IsUnknown() {
+		payloadMap["nodes"] = getSetStringJsonPayload(ctx, data.NodeIds)
+	} else {
+		payloadMap["nodes"] = []string{}
+	}
+
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		payloadMap["labels"] = getSetStringJsonPayload(ctx, data.Labels)
+	}
+
+	if isAnnotationsPayloadRequired(data.Annotations) {
+		payloadMap["annotations"] = getAnnotationsJsonPayload(ctx, data.Annotations)
+	}
+
+	var payload map[string]interface{}
+	if action == "create" {
+		payloadList = append(payloadList, payloadMap)
+		payload = map[string]interface{}{"staticRoutes": payloadList}
+	} else {
+		payload = payloadMap
+	}
+
+	marshalPayload, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddError(
+			"Marshalling of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+
+	jsonPayload, err := gabs.ParseJSON(marshalPayload)
+	if err != nil {
+		diags.AddError(
+			"Construction of JSON payload failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
+	}
+	return jsonPayload
+}
+
+// splitVrfId splits an id in the {fabricId}/vrfs/{vrfId} format into the Fabric and VRF ids.
+func splitVrfId(vrfId string) (string, string) {
+	if !strings.Contains(vrfId, "/vrfs/") {
+		return "", ""
+	}
+	splitId := strings.SplitN(vrfId, "/vrfs/", 2)
+	return splitId[0], strings.Split(splitId[1], "/")[0]
+}
+
+func checkAndSetVrfStaticRouteIds(data *VrfStaticRouteResourceModel) {
+	if strings.Contains(data.Id.ValueString(), "/staticRoutes/") {
+		if data.VrfId.IsNull() || data.VrfId.IsUnknown() || data.VrfId.ValueString() == "" ||
+			data.StaticRouteId.IsNull() || data.StaticRouteId.IsUnknown() || data.StaticRouteId.ValueString() == "" {
+			splitId := strings.Split(data.Id.ValueString(), "/staticRoutes/")
+			data.VrfId = basetypes.NewStringValue(splitId[0])
+			data.StaticRouteId = basetypes.NewStringValue(splitId[1])
+		}
+	}
+}
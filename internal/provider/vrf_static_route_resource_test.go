@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVrfStaticRouteResource(t *testing.T) {
+	fabricName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validate that a prefix with host bits set is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Validate that a prefix with host bits set is rejected during plan.")
+				},
+				Config:      testVrfStaticRouteResourceHclConfig(fabricName, "invalid_prefix"),
+				ExpectError: regexp.MustCompile(`Invalid IP Prefix`),
+			},
+			// Validate that a Static Route without next-hops and not discarded is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Validate that a Static Route without next-hops and not discarded is rejected during plan.")
+				},
+				Config:      testVrfStaticRouteResourceHclConfig(fabricName, "missing_next_hops"),
+				ExpectError: regexp.MustCompile(`Missing Static Route Next-Hops`),
+			},
+			// Validate that a next-hop of another IP family than the prefix is rejected during plan.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Validate that a next-hop of another IP family than the prefix is rejected during plan.")
+				},
+				Config:      testVrfStaticRouteResourceHclConfig(fabricName, "invalid_family"),
+				ExpectError: regexp.MustCompile(`Invalid Static Route Next-Hop`),
+			},
+			// Create with minimum config and verify provided and default Hyperfabric values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Create with minimum config and verify provided and default Hyperfabric values.")
+				},
+				Config:             testVrfStaticRouteResourceHclConfig(fabricName, "minimal"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("hyperfabric_vrf_static_route.test", "vrf_id", "hyperfabric_vrf.test", "id"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "name", "default-egress"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "prefix", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "next_hops.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "next_hops.0.address", "10.1.1.1"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "null0", "false"),
+					resource.TestCheckResourceAttrSet("hyperfabric_vrf_static_route.test", "distance"),
+					resource.TestCheckNoResourceAttr("hyperfabric_vrf_static_route.test", "node_ids"),
+				),
+			},
+			// ImportState testing with pre-existing Id.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - ImportState testing with pre-existing Id.")
+				},
+				ResourceName:      "hyperfabric_vrf_static_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update with all config and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Update with all config and verify provided values.")
+				},
+				Config:             testVrfStaticRouteResourceHclConfig(fabricName, "full"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "name", "default-egress"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "description", "Default route to the border router"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "prefix", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "next_hops.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "distance", "10"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "node_ids.#", "1"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "labels.#", "2"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "annotations.#", "2"),
+				),
+			},
+			// Run Plan Only with all config and check that plan is empty.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Run Plan Only with all config and check that plan is empty.")
+				},
+				Config:             testVrfStaticRouteResourceHclConfig(fabricName, "full"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Update with a discard route and verify provided values.
+			{
+				PreConfig: func() {
+					fmt.Println("= RUNNING: VRF Static Route - Update with a discard route and verify provided values.")
+				},
+				Config:             testVrfStaticRouteResourceHclConfig(fabricName, "null0"),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "prefix", "2001:db8::/32"),
+					resource.TestCheckResourceAttr("hyperfabric_vrf_static_route.test", "null0", "true"),
+					resource.TestCheckNoResourceAttr("hyperfabric_vrf_static_route.test", "next_hops"),
+					resource.TestCheckNoResourceAttr("hyperfabric_vrf_static_route.test", "node_ids"),
+				),
+			},
+		},
+	})
+}
+
+func testVrfStaticRouteResourceHclConfig(fabricName string, configType string) string {
+	base := fmt.Sprintf(`
+resource "hyperfabric_fabric" "test" {
+	name = "%[1]s"
+}
+
+resource "hyperfabric_node" "test" {
+	fabric_id  = hyperfabric_fabric.test.id
+	name       = "node1"
+	model_name = "HF6100-32D"
+	roles      = ["LEAF"]
+}
+
+resource "hyperfabric_vrf" "test" {
+	fabric_id = hyperfabric_fabric.test.id
+	name      = "Vrf1"
+}
+`, fabricName)
+
+	if configType == "invalid_prefix" {
+		return base + `
+resource "hyperfabric_vrf_static_route" "test" {
+	vrf_id = hyperfabric_vrf.test.id
+	name   = "default-egress"
+	prefix = "10.1.1.1/24"
+	next_hops = [
+		{
+			address = "10.1.1.1"
+		}
+	]
+}
+`
+	} else if configType == "missing_next_hops" {
+		return base + `
+resource "hyperfabric_vrf_static_route" "test" {
+	vrf_id = hyperfabric_vrf.test.id
+	name   = "default-egress"
+	prefix = "0.0.0.0/0"
+}
+`
+	} else if configType == "invalid_family" {
+		return base + `
+resource "hyperfabric_vrf_static_route" "test" {
+	vrf_id = hyperfabric_vrf.test.id
+	name   = "default-egress"
+	prefix = "0.0.0.0/0"
+	next_hops = [
+		{
+			address = "2001:1::1"
+		}
+	]
+}
+`
+	} else if configType == "full" {
+		return base + `
+resource "hyperfabric_vrf_static_route" "test" {
+	vrf_id      = hyperfabric_vrf.test.id
+	name        = "default-egress"
+	description = "Default route to the border router"
+	prefix      = "0.0.0.0/0"
+	distance    = 10
+	node_ids    = [hyperfabric_node.test.node_id]
+	next_hops = [
+		{
+			address = "10.1.1.1"
+		},
+		{
+			address   = "10.1.2.1"
+			interface = "Ethernet1_10"
+		}
+	]
+	labels = [
+		"sj01-1-101-AAA01",
+		"blue"
+	]
+	annotations = [
+		{
+			name  = "color"
+			value = "blue"
+		},
+		{
+			data_type = "UINT32"
+			name      = "rack"
+			value     = "1"
+		}
+	]
+}
+`
+	} else if configType == "null0" {
+		return base + `
+resource "hyperfabric_vrf_static_route" "test" {
+	vrf_id = hyperfabric_vrf.test.id
+	name   = "default-egress"
+	prefix = "2001:db8::/32"
+	null0  = true
+}
+`
+	} else {
+		return base + `
+resource "hyperfabric_vrf_static_route" "test" {
+	vrf_id = hyperfabric_vrf.test.id
+	name   = "default-egress"
+	prefix = "0.0.0.0/0"
+	next_hops = [
+		{
+			address = "10.1.1.1"
+		}
+	]
+}
+`
+	}
+}
@@ -0,0 +1,241 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-hyperfabric/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VrfsDataSource{}
+
+func NewVrfsDataSource() datasource.DataSource {
+	return &VrfsDataSource{}
+}
+
+// VrfsDataSource defines the data source implementation.
+type VrfsDataSource struct {
+	client *client.Client
+}
+
+// VrfsDataSourceModel describes the data source data model.
+type VrfsDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Vrfs        types.List   `tfsdk:"vrfs"`
+}
+
+// VrfSummaryDataSourceModel describes a VRF in the list of VRFs.
+type VrfSummaryDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	VrfId       types.String `tfsdk:"vrf_id"`
+	FabricId    types.String `tfsdk:"fabric_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	Asn         types.Int64  `tfsdk:"asn"`
+	Vni         types.Int64  `tfsdk:"vni"`
+	RouteTarget types.String `tfsdk:"route_target"`
+	Labels      types.Set    `tfsdk:"labels"`
+	Annotations types.Set    `tfsdk:"annotations"`
+}
+
+func VrfSummaryDataSourceModelAttributeType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":           types.StringType,
+			"vrf_id":       types.StringType,
+			"fabric_id":    types.StringType,
+			"name":         types.StringType,
+			"description":  types.StringType,
+			"enabled":      types.BoolType,
+			"is_default":   types.BoolType,
+			"asn":          types.Int64Type,
+			"vni":          types.Int64Type,
+			"route_target": types.StringType,
+			"labels":       types.SetType{ElemType: SetStringResourceModelAttributeType()},
+			"annotations":  types.SetType{ElemType: AnnotationResourceModelAttributeType()},
+		},
+	}
+}
+
+func (d *VrfsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: hyperfabric_vrfs")
+	resp.TypeName = req.ProviderTypeName + "_vrfs"
+	tflog.Debug(ctx, "End metadata of datasource: hyperfabric_vrfs")
+}
+
+func (d *VrfsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: hyperfabric_vrfs")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "VRFs data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`id` is set to the `fabric_id` followed by `/vrfs`.",
+				Computed:            true,
+			},
+			"fabric_id": schema.StringAttribute{
+				MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+				Required:            true,
+			},
+			"name_regex":  getNameRegexFilterSchemaAttribute("VRFs"),
+			"labels":      getLabelsFilterSchemaAttribute("VRFs"),
+			"annotations": getAnnotationsFilterSchemaAttribute("VRFs"),
+			"enabled":     getEnabledFilterSchemaAttribute("VRFs"),
+			"vrfs": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of VRFs matching the filters sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "`id` defines the unique identifier of a VRF in a Fabric.",
+							Computed:            true,
+						},
+						"vrf_id": schema.StringAttribute{
+							MarkdownDescription: "`vrf_id` defines the unique identifier of a VRF.",
+							Computed:            true,
+						},
+						"fabric_id": schema.StringAttribute{
+							MarkdownDescription: "`fabric_id` defines the unique identifier of a Fabric.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the VRF.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description is a user defined field to store notes about the VRF.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "The enabled admin state of the VRF.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "The flag that denote if the VRF is the default VRF or not.",
+							Computed:            true,
+						},
+						"asn": schema.Int64Attribute{
+							MarkdownDescription: "The Autonomous System Number (ASN) used for the VRF external connections.",
+							Computed:            true,
+						},
+						"vni": schema.Int64Attribute{
+							MarkdownDescription: "The VXLAN Network Identifier (VNI) used for the VRF.",
+							Computed:            true,
+						},
+						"route_target": schema.StringAttribute{
+							MarkdownDescription: "The route target associated with the VRF.",
+							Computed:            true,
+						},
+						"labels":      getLabelsDataSourceSchemaAttribute(),
+						"annotations": getAnnotationsDataSourceSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: hyperfabric_vrfs")
+}
+
+func (d *VrfsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: hyperfabric_vrfs")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: hyperfabric_vrfs")
+}
+
+func (d *VrfsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: hyperfabric_vrfs")
+	var data *VrfsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(fmt.Sprintf("%s/vrfs", data.FabricId.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource hyperfabric_vrfs with id '%s'", data.Id.ValueString()))
+
+	filters := getObjectFilters(ctx, data.NameRegex, data.Labels, data.Annotations, basetypes.NewSetNull(types.StringType), data.Enabled)
+	vrfs := getVrfsList(ctx, &resp.Diagnostics, d.client, data.FabricId.ValueString(), filters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Vrfs, _ = types.ListValueFrom(ctx, VrfSummaryDataSourceModelAttributeType(), vrfs)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource hyperfabric_vrfs with id '%s'", data.Id.ValueString()))
+}
+
+// getVrfsList returns the VRFs of the Fabric matching the filters sorted by name.
+func getVrfsList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, fabricId string, filters ObjectFilters) []VrfSummaryDataSourceModel {
+	vrfs := make([]VrfSummaryDataSourceModel, 0)
+	for _, vrf := range getObjectsList(ctx, diags, client, fmt.Sprintf("/api/v1/fabrics/%s/vrfs", fabricId), "vrfs") {
+		if !filters.matches(vrf, "name") {
+			continue
+		}
+
+		vrfFabricId := getStringFromMap(vrf, "fabricId")
+		if vrfFabricId == "" {
+			vrfFabricId = fabricId
+		}
+		vrfs = append(vrfs, VrfSummaryDataSourceModel{
+			Id:          basetypes.NewStringValue(fmt.Sprintf("%s/vrfs/%s", vrfFabricId, getStringFromMap(vrf, "id"))),
+			VrfId:       basetypes.NewStringValue(getStringFromMap(vrf, "id")),
+			FabricId:    basetypes.NewStringValue(vrfFabricId),
+			Name:        basetypes.NewStringValue(getStringFromMap(vrf, "name")),
+			Description: basetypes.NewStringValue(getStringFromMap(vrf, "description")),
+			Enabled:     basetypes.NewBoolValue(vrf["enabled"] == true),
+			IsDefault:   basetypes.NewBoolValue(vrf["isDefault"] == true),
+			Asn:         basetypes.NewInt64Value(getInt64FromMap(vrf, "asn")),
+			Vni:         basetypes.NewInt64Value(getInt64FromMap(vrf, "vni")),
+			RouteTarget: basetypes.NewStringValue(getStringFromMap(vrf, "routeTarget")),
+			Labels:      getObjectLabelsSet(ctx, vrf),
+			Annotations: getObjectAnnotationsSet(ctx, vrf),
+		})
+	}
+
+	sort.SliceStable(vrfs, func(i, j int) bool {
+		return vrfs[i].Name.ValueString() < vrfs[j].Name.ValueString()
+	})
+	return vrfs
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateStateGetter is implemented by the private state of the resource requests.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the private state of the resource responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// isWriteOnlySecretRequired returns true when a configured write-only secret has to be sent to the Fabric, which is when
+// the secret is unknown, when the version of the secret changes or when the secret set by the provider is outdated,
+// as recorded in the private state of the resource.
+func isWriteOnlySecretRequired(secret types.String, planVersion, stateVersion types.Int64, outdated bool) bool {
+	if secret.IsNull() {
+		return false
+	}
+	return secret.IsUnknown() || !planVersion.Equal(stateVersion) || outdated
+}
+
+// getWriteOnlySecretPrivateState reads the value recorded under key in the private state when a write-only secret was
+// last set by the provider into value. False is returned when no value has been recorded.
+func getWriteOnlySecretPrivateState(ctx context.Context, private privateStateGetter, key string, value interface{}) bool {
+	privateBytes, diags := private.GetKey(ctx, key)
+	if diags.HasError() || privateBytes == nil {
+		return false
+	}
+	return json.Unmarshal(privateBytes, value) == nil
+}
+
+// setWriteOnlySecretPrivateState records the value under key in the private state after a write-only secret has been
+// set by the provider.
+func setWriteOnlySecretPrivateState(ctx context.Context, diags *diag.Diagnostics, private privateStateSetter, key string, value interface{}) {
+	privateBytes, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError(
+			"Marshalling of private data failed",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+	diags.Append(private.SetKey(ctx, key, privateBytes)...)
+}